@@ -61,6 +61,78 @@ func Bidx(z complex128) complex128 {
 	return complex(bir, bii)
 }
 
+// AiReal computes the Airy Ai function for real arguments
+func AiReal(x float64) float64 {
+	return toms.AIRYAI(x)
+}
+
+// AixReal computes the exponentially scaled Airy Ai function for real arguments
+// AixReal = e^((2/3)*x^(3/2)) * Ai(x) for x > 0 and Ai(x) for x <= 0
+func AixReal(x float64) float64 {
+	return toms.AIRYAIE(x)
+}
+
+// AidReal computes the first derivative of the Airy Ai function for real arguments dAi(x)/dx
+func AidReal(x float64) float64 {
+	return toms.AIRYAID(x)
+}
+
+// AidxReal computes the exponentially scaled first derivative of the Airy Ai function for real arguments
+// AidxReal = e^((2/3)*x^(3/2)) * dAi(x)/dx for x > 0 and dAi(x)/dx for x <= 0
+func AidxReal(x float64) float64 {
+	return toms.AIRYAIDE(x)
+}
+
+// BiReal computes the Airy Bi function for real arguments
+func BiReal(x float64) float64 {
+	return toms.AIRYBI(x)
+}
+
+// BixReal computes the exponentially scaled Airy Bi function for real arguments
+// BixReal = e^(-(2/3)*x^(3/2)) * Bi(x) for x > 0 and Bi(x) for x <= 0
+func BixReal(x float64) float64 {
+	return toms.AIRYBIE(x)
+}
+
+// BidReal computes the first derivative of the Airy Bi function for real arguments dBi(x)/dx
+func BidReal(x float64) float64 {
+	return toms.AIRYBID(x)
+}
+
+// BidxReal computes the exponentially scaled first derivative of the Airy Bi function for real arguments
+// BidxReal = e^(-(2/3)*x^(3/2)) * dBi(x)/dx for x > 0 and dBi(x)/dx for x <= 0
+func BidxReal(x float64) float64 {
+	return toms.AIRYBIDE(x)
+}
+
+// AiryM computes the modulus of the Airy functions for x ≤ 0
+// AiryM = √(Ai(x)² + Bi(x)²), see DLMF 9.8
+func AiryM(x float64) float64 {
+	m, _ := toms.AIRYMP(x, 0)
+	return m
+}
+
+// AiryTheta computes the phase θ of the Airy functions for x ≤ 0
+// Ai(x) = AiryM(x) cos(θ(x)) and Bi(x) = AiryM(x) sin(θ(x)), with θ continuous and θ(0) = π/3
+func AiryTheta(x float64) float64 {
+	_, θ := toms.AIRYMP(x, 0)
+	return θ
+}
+
+// AiryN computes the modulus of the first derivatives of the Airy functions for x ≤ 0
+// AiryN = √(Ai'(x)² + Bi'(x)²), see DLMF 9.8
+func AiryN(x float64) float64 {
+	n, _ := toms.AIRYMP(x, 1)
+	return n
+}
+
+// AiryPhi computes the phase φ of the first derivatives of the Airy functions for x ≤ 0
+// Ai'(x) = AiryN(x) cos(φ(x)) and Bi'(x) = AiryN(x) sin(φ(x)), with φ continuous and φ(0) = 2π/3
+func AiryPhi(x float64) float64 {
+	_, φ := toms.AIRYMP(x, 1)
+	return φ
+}
+
 // Gi calculates the modified Airy function Gi
 //    ∫  0 to infinity sin(x*t+t^3/3) dt  / pi
 func Gi(x float64) float64 {
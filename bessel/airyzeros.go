@@ -0,0 +1,156 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The zeros of the Airy functions are found by Newton's method from the asymptotic expansions
+//    a(k) = -T(3π/8 (4k-1)), a'(k) = -U(3π/8 (4k-3))
+//    b(k) = -T(3π/8 (4k-3)), b'(k) = -U(3π/8 (4k-1))
+// and the complex zeros of Bi in the upper half plane from
+//    β(k) = exp(πi/3) T(3π/8 (4k-1) + (3/4) i log(2))
+// where Ai'' = z Ai and Bi'' = z Bi give the derivatives needed for the zeros of Ai' and Bi'.
+// All zeros of Ai and Ai' are real and negative, while Bi and Bi' also have zeros in the
+// sectors π/3 < |ph(z)| < π/2. See Abramowitz and Stegun 10.4.94 to 10.4.106 and DLMF 9.9.
+
+// The kinds of Airy zero
+const (
+	zeroAi = iota
+	zeroBi
+	zeroAid
+	zeroBid
+)
+
+// AiZero computes the k-th negative zero a(k) of the Airy function Ai
+func AiZero(k int) float64 {
+	return airyZero(k, zeroAi)
+}
+
+// AiZeros computes the first n negative zeros of the Airy function Ai
+func AiZeros(n int) []float64 {
+	return airyZeros(n, zeroAi)
+}
+
+// BiZero computes the k-th negative zero b(k) of the Airy function Bi
+func BiZero(k int) float64 {
+	return airyZero(k, zeroBi)
+}
+
+// BiZeros computes the first n negative zeros of the Airy function Bi
+func BiZeros(n int) []float64 {
+	return airyZeros(n, zeroBi)
+}
+
+// AidZero computes the k-th negative zero a'(k) of the derivative of the Airy function Ai
+func AidZero(k int) float64 {
+	return airyZero(k, zeroAid)
+}
+
+// AidZeros computes the first n negative zeros of the derivative of the Airy function Ai
+func AidZeros(n int) []float64 {
+	return airyZeros(n, zeroAid)
+}
+
+// BidZero computes the k-th negative zero b'(k) of the derivative of the Airy function Bi
+func BidZero(k int) float64 {
+	return airyZero(k, zeroBid)
+}
+
+// BidZeros computes the first n negative zeros of the derivative of the Airy function Bi
+func BidZeros(n int) []float64 {
+	return airyZeros(n, zeroBid)
+}
+
+// AidAtAiZero computes Ai'(a(k)), the derivative of the Airy function Ai at its k-th zero
+func AidAtAiZero(k int) float64 {
+	return real(Aid(complex(airyZero(k, zeroAi), 0)))
+}
+
+// AiAtAidZero computes Ai(a'(k)), the Airy function Ai at the k-th zero of its derivative
+func AiAtAidZero(k int) float64 {
+	return real(Ai(complex(airyZero(k, zeroAid), 0)))
+}
+
+// BiComplexZero computes the k-th zero β(k) of the Airy function Bi in the upper half plane,
+// in order of increasing modulus. The zeros in the lower half plane are their conjugates.
+func BiComplexZero(k int) complex128 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+	t := complex(3*math.Pi/8*float64(4*k-1), 0.75*math.Ln2)
+	t2 := 1 / (t * t)
+	z := complex(0.5, math.Sqrt(3)/2) * cmplx.Pow(t, 2/3.0) * (1 + t2*(5/48.0-t2*(5/36.0-t2*77125/82944.0)))
+	for i := 0; i < 100; i++ {
+		δ := Bi(z) / Bid(z)
+		z -= δ
+		if cmplx.Abs(δ) <= 2*machineEpsilon*cmplx.Abs(z) {
+			break
+		}
+	}
+	return z
+}
+
+// BiComplexZeros computes the first n zeros of the Airy function Bi in the upper half plane
+func BiComplexZeros(n int) []complex128 {
+	if n < 0 {
+		panic("n must be non-negative")
+	}
+	zeros := make([]complex128, n)
+	for k := range zeros {
+		zeros[k] = BiComplexZero(k + 1)
+	}
+	return zeros
+}
+
+func airyZeros(n int, kind int) []float64 {
+	if n < 0 {
+		panic("n must be non-negative")
+	}
+	zeros := make([]float64, n)
+	for k := range zeros {
+		zeros[k] = airyZero(k+1, kind)
+	}
+	return zeros
+}
+
+func airyZero(k int, kind int) float64 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+
+	var x float64
+	switch kind {
+	case zeroAi:
+		x = -airyZeroT(3 * math.Pi / 8 * float64(4*k-1))
+	case zeroBi:
+		x = -airyZeroT(3 * math.Pi / 8 * float64(4*k-3))
+	case zeroAid:
+		x = -airyZeroU(3 * math.Pi / 8 * float64(4*k-3))
+	case zeroBid:
+		x = -airyZeroU(3 * math.Pi / 8 * float64(4*k-1))
+	}
+	for i := 0; i < 100; i++ {
+		z := complex(x, 0)
+		var δ float64
+		switch kind {
+		case zeroAi:
+			δ = real(Ai(z)) / real(Aid(z))
+		case zeroBi:
+			δ = real(Bi(z)) / real(Bid(z))
+		case zeroAid:
+			δ = real(Aid(z)) / (x * real(Ai(z)))
+		case zeroBid:
+			δ = real(Bid(z)) / (x * real(Bi(z)))
+		}
+		x -= δ
+		if math.Abs(δ) <= 2*machineEpsilon*math.Abs(x) {
+			break
+		}
+	}
+	return x
+}
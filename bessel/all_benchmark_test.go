@@ -82,6 +82,134 @@ func BenchmarkAiryBiLargeNegative(b *testing.B) {
 	GlobalC = ζ
 }
 
+func BenchmarkAiryAiRealAxis(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Ai(2.5)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryAiReal(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = AiReal(2.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryAiRealAxisNegative(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Ai(-20.2)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryAiRealNegative(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = AiReal(-20.2)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryAidRealAxis(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Aid(2.5)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryAidReal(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = AidReal(2.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryAidRealAxisNegative(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Aid(-20.2)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryAidRealNegative(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = AidReal(-20.2)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryBiRealAxis(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Bi(2.5)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryBiReal(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = BiReal(2.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryBiRealAxisNegative(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Bi(-20.2)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryBiRealNegative(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = BiReal(-20.2)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryBidRealAxis(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Bid(2.5)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryBidReal(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = BidReal(2.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkAiryBidRealAxisNegative(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Bid(-20.2)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryBidRealNegative(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = BidReal(-20.2)
+	}
+	GlobalF = ζ
+}
+
 func BenchmarkAiryGi(b *testing.B) {
 	var ζ float64
 	for n := 0; n < b.N; n++ {
@@ -97,3 +225,93 @@ func BenchmarkAiryHi(b *testing.B) {
 	}
 	GlobalF = ζ
 }
+
+func BenchmarkAiryGic(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Gic(3.2 + 1.5i)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkAiryHic(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = Hic(-3.2 + 1.5i)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkBesselJ(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		for k := 0; k < 32; k++ {
+			ζ = J(float64(k), 3.2+0.5i)
+		}
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkBesselJSeq(b *testing.B) {
+	dst := make([]complex128, 32)
+	for n := 0; n < b.N; n++ {
+		_, _ = JSeq(0, 32, 3.2+0.5i, dst)
+	}
+	GlobalC = dst[31]
+}
+
+func BenchmarkBesselJ0Complex(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = J(0, 3.2)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkBesselJ0(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = J0(3.2)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkBesselY1(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = Y1(12.7)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkBesselK0Complex(b *testing.B) {
+	var ζ complex128
+	for n := 0; n < b.N; n++ {
+		ζ = K(0, 1.5)
+	}
+	GlobalC = ζ
+}
+
+func BenchmarkBesselK0(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = K0(1.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkBesselI1(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = I1(5.5)
+	}
+	GlobalF = ζ
+}
+
+func BenchmarkBesselCrossJY(b *testing.B) {
+	var ζ float64
+	for n := 0; n < b.N; n++ {
+		ζ = CrossJY(1, 2.5, 3.5)
+	}
+	GlobalF = ζ
+}
@@ -503,6 +503,201 @@ func TestAiryBidx(t *testing.T) {
 	}
 }
 
+func TestAiryReal(t *testing.T) {
+	testCases := []struct {
+		x                                      float64
+		ai, aid, bi, bid, aix, aidx, bix, bidx float64
+	}{
+		// extended precision values
+		{-1000, 0.05597189577301991884219183, 2.633071019524128731078853, -0.08326457411708063301147756, 1.769965940135988979753120, 0.05597189577301991884219183, 2.633071019524128731078853, -0.08326457411708063301147756, 1.769965940135988979753120},
+		{-30.5, -0.004333637288742865446947236, -1.325690330366255509726477, 0.2400369726830609525060923, -0.02196597479789601924130090, -0.004333637288742865446947236, -1.325690330366255509726477, 0.2400369726830609525060923, -0.02196597479789601924130090},
+		{-7.5, 0.3217757163806478752673285, 0.3188095066985545962100629, -0.1124634850764908063843208, 0.8778022815457609223675813, 0.3217757163806478752673285, 0.3188095066985545962100629, -0.1124634850764908063843208, 0.8778022815457609223675813},
+		{-2.5, -0.1123250676929660891874631, 0.6788527342647943633721400, -0.4324224718407052930284195, -0.2204201548746295876833984, -0.1123250676929660891874631, 0.6788527342647943633721400, -0.4324224718407052930284195, -0.2204201548746295876833984},
+		{-1, 0.5355608832923521187995166, -0.01016056711664520939504547, 0.1039973894969446118886900, 0.5923756264227923508167792, 0.5355608832923521187995166, -0.01016056711664520939504547, 0.1039973894969446118886900, 0.5923756264227923508167792},
+		{-0.25, 0.4187246142754529242283812, -0.2463891899201759730286850, 0.5013998734692333889674858, 0.4651514883371537032706730, 0.4187246142754529242283812, -0.2463891899201759730286850, 0.5013998734692333889674858, 0.4651514883371537032706730},
+		{0, 0.3550280538878172392600632, -0.2588194037928067984051836, 0.6149266274460007351509224, 0.4482883573538263579148237, 0.3550280538878172392600632, -0.2588194037928067984051836, 0.6149266274460007351509224, 0.4482883573538263579148237},
+		{0.5, 0.2316936064808334897691253, -0.2249105326646838931359970, 0.8542770431031554933000488, 0.5445725641405923018271640, 0.2932771591299473624508974, -0.2846911620919425689463801, 0.6748924111156302128654143, 0.4302209614637693916733277},
+		{1, 0.1352924163128814155241474, -0.1591474412967932127875003, 1.207423594952871259436379, 0.9324359333927756329594515, 0.2635136447491400685713177, -0.3099768889605148473687813, 0.6199119435726784850503016, 0.4787285706049847378849861},
+		{2.5, 0.01572592338047048999526605, -0.02625088103590323036489550, 6.481660738460578608072613, 9.421423317334301755582309, 0.2193222051287120608628509, -0.3661089384751622168413765, 0.4647504801960925150197754, 0.6755384441644994191334264},
+		{4.5, 3.302503235143089836587326e-4, -7.178665675575088886935543e-4, 227.5880818355997184614109, 469.1350773279663979509197, 0.1917239687239853616178640, -0.4167512264084951679196780, 0.3920273409445906042610107, 0.8080993320272777660992395},
+		{10, 1.104753255289868593355021e-10, -3.520633676738923636620645e-10, 4.556411535482251409997873e8, 1.429236134482865776118831e9, 0.1581236668543461502767059, -0.5039093607113109261715793, 0.3183401053367344452504857, 0.9985559426738374008966472},
+		{30.5, 2.042534616669260159633023e-50, -1.129694662719963766022217e-49, 1.410922562017126984130719e48, 7.780466294409502806154117e48, 0.1199644920979014993033607, -0.6635052612224850978008588, 0.2402259305201588343401845, 1.324714626990681381119572},
+		{100, 2.634482152088184489550553e-291, -2.635140361604409933602875e-290, 6.041223996670201399005265e288, 6.039712745310602909362431e289, 0.08919692093633041317538664, -0.8921920625040314863734124, 0.1784310111708354151403586, 1.783863754962808735241020},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64) float64
+			y    float64
+		}{{"AiReal", AiReal, tc.ai}, {"AidReal", AidReal, tc.aid}, {"BiReal", BiReal, tc.bi}, {"BidReal", BidReal, tc.bid},
+			{"AixReal", AixReal, tc.aix}, {"AidxReal", AidxReal, tc.aidx}, {"BixReal", BixReal, tc.bix}, {"BidxReal", BidxReal, tc.bidx}} {
+			// the functions only have absolute accuracy near their zeros for x < 0
+			if ζ := f.f(tc.x); close(ζ, f.y) == false && (tc.x >= 0 || math.Abs(ζ-f.y) > 1e-15*math.Max(1, math.Sqrt(-tc.x))) {
+				t.Fatalf("%v(%v): expected %v, got %v", f.name, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestAiryRealSpecial(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	testCases := []struct {
+		x                                      float64
+		ai, aid, bi, bid, aix, aidx, bix, bidx float64
+	}{
+		{inf, 0, 0, inf, inf, 0, -inf, 0, inf},
+		{-inf, 0, nan, 0, nan, 0, nan, 0, nan},
+		{nan, nan, nan, nan, nan, nan, nan, nan, nan},
+		// Ai underflows to a subnormal number and Bi overflows
+		{105, 2.70062041745e-313, -2.76795507736e-312, inf, inf, 0.08811619311598289, -0.9031319713010111, 0.1762665136171892, 1.805774360416401},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64) float64
+			y    float64
+		}{{"AiReal", AiReal, tc.ai}, {"AidReal", AidReal, tc.aid}, {"BiReal", BiReal, tc.bi}, {"BidReal", BidReal, tc.bid},
+			{"AixReal", AixReal, tc.aix}, {"AidxReal", AidxReal, tc.aidx}, {"BixReal", BixReal, tc.bix}, {"BidxReal", BidxReal, tc.bidx}} {
+			ζ := f.f(tc.x)
+			if math.IsNaN(f.y) && math.IsNaN(ζ) || ζ == f.y {
+				continue
+			}
+			if soclose(ζ, f.y, 1e-10) == false {
+				t.Fatalf("%v(%v): expected %v, got %v", f.name, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestAiryRealComplex(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64) float64
+		// g for x > 0, and the unscaled function u and its partner v in the modulus for x ≤ 0
+		g, u, v func(complex128) complex128
+	}{
+		{"AiReal", AiReal, Ai, Ai, Bi},
+		{"AidReal", AidReal, Aid, Aid, Bid},
+		{"BiReal", BiReal, Bi, Bi, Ai},
+		{"BidReal", BidReal, Bid, Bid, Aid},
+		{"AixReal", AixReal, Aix, Ai, Bi},
+		{"AidxReal", AidxReal, Aidx, Aid, Bid},
+		{"BixReal", BixReal, Bix, Bi, Ai},
+		{"BidxReal", BidxReal, Bidx, Bid, Aid},
+	}
+
+	for _, tc := range testCases {
+		for x := -60.0; x < 60; x += 0.37 {
+			z := complex(x, 0)
+			ζ := tc.f(x)
+			y := real(tc.g(z))
+			e := math.Abs(y)
+			if x <= 0 {
+				// the functions only have absolute accuracy near their zeros
+				y = real(tc.u(z))
+				e = math.Hypot(y, real(tc.v(z)))
+			}
+			if math.Abs(ζ-y) > 1e-13*e {
+				t.Fatalf("%v(%v): expected %v, got %v", tc.name, x, y, ζ)
+			}
+		}
+	}
+}
+
+func TestAiryModulusPhase(t *testing.T) {
+	testCases := []struct {
+		x, m, θ, n, φ float64
+	}{
+		// extended precision values
+		{0, 0.7100561077756344785201264, 1.047197551196597746154214, 0.5176388075856135968103671, 2.094395102393195492308429},
+		{-0.5, 0.6090856778213610950737309, 0.6744463133520213387479374, 0.5455439952467598111088807, 1.954209915978229604876997},
+		{-1, 0.5455647685977015253170095, 0.1917971117272988059727437, 0.5924627582421763453952723, 1.587946881998348747848128},
+		{-2.5, 0.4467730016295243599247423, -1.824937416418237042631622, 0.7137409050164794952455592, -0.3139561006679876954483810},
+		{-7.5, 0.3408630914719709158198588, -12.90260788445840830224421, 0.9339038210909724371450721, -11.34395150033134430268903},
+		{-30.5, 0.2400760893279439699527254, -111.5084871228558885028053, 1.325872300063401082555500, -109.9391749303280413626192},
+		{-1000, 0.1003286719703027821088629, -2.108106566633175887279919e4, 3.172671181055375305075591, -2.107949487791065811778935e4},
+		{-1e6, 0.01784124116152771114399582, -6.666666658812685031650517e8, 17.84124116152771114734105, -6.666666643104721766201551e8},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64) float64
+			y    float64
+		}{{"AiryM", AiryM, tc.m}, {"AiryTheta", AiryTheta, tc.θ}, {"AiryN", AiryN, tc.n}, {"AiryPhi", AiryPhi, tc.φ}} {
+			if ζ := f.f(tc.x); close(ζ, f.y) == false {
+				t.Fatalf("%v(%v): expected %v, got %v", f.name, tc.x, f.y, ζ)
+			}
+		}
+		// Ai(x) = M(x) cos(θ(x)), Bi(x) = M(x) sin(θ(x)) and likewise for the derivatives,
+		// where the rounding of the phases to float64 does not dominate
+		if tc.x < -100 {
+			continue
+		}
+		for _, f := range []struct {
+			name string
+			f    func(float64) float64
+			y    float64
+		}{
+			{"AiReal", AiReal, tc.m * math.Cos(tc.θ)},
+			{"BiReal", BiReal, tc.m * math.Sin(tc.θ)},
+			{"AidReal", AidReal, tc.n * math.Cos(tc.φ)},
+			{"BidReal", BidReal, tc.n * math.Sin(tc.φ)},
+		} {
+			if ζ := f.f(tc.x); math.Abs(ζ-f.y) > 1e-13*math.Max(tc.m, tc.n) {
+				t.Fatalf("%v(%v): expected %v, got %v", f.name, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestAiryModulusPhaseConsistency(t *testing.T) {
+	θ0, φ0 := AiryTheta(0), AiryPhi(0)
+	for x := 0.0; x > -60; x -= 0.01 {
+		z := complex(x, 0)
+		m, θ, n, φ := AiryM(x), AiryTheta(x), AiryN(x), AiryPhi(x)
+		ai, bi, aid, bid := real(Ai(z)), real(Bi(z)), real(Aid(z)), real(Bid(z))
+		if math.Abs(m*math.Cos(θ)-ai) > 1e-13*m || math.Abs(m*math.Sin(θ)-bi) > 1e-13*m {
+			t.Fatalf("AiryM(%v) and AiryTheta(%v): expected %v and %v, got %v and %v", x, x, ai, bi, m*math.Cos(θ), m*math.Sin(θ))
+		}
+		if math.Abs(n*math.Cos(φ)-aid) > 1e-13*n || math.Abs(n*math.Sin(φ)-bid) > 1e-13*n {
+			t.Fatalf("AiryN(%v) and AiryPhi(%v): expected %v and %v, got %v and %v", x, x, aid, bid, n*math.Cos(φ), n*math.Sin(φ))
+		}
+		// the phases are continuous, and θ increases with x
+		if θ > θ0+1e-15 || θ < θ0-0.1 || math.Abs(φ-φ0) > 0.1 {
+			t.Fatalf("AiryTheta(%v) or AiryPhi(%v) is not continuous: %v, %v after %v, %v", x, x, θ, φ, θ0, φ0)
+		}
+		θ0, φ0 = θ, φ
+	}
+}
+
+func TestAiryModulusPhaseSpecial(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		x, m, θ, n, φ float64
+	}{
+		{0, 0.7100561077756344785201264, math.Pi / 3, 0.5176388075856135968103671, 2 * math.Pi / 3},
+		{-inf, 0, -inf, inf, -inf},
+	}
+
+	for _, tc := range testCases {
+		if m, θ, n, φ := AiryM(tc.x), AiryTheta(tc.x), AiryN(tc.x), AiryPhi(tc.x); m != tc.m && !close(m, tc.m) ||
+			θ != tc.θ && !close(θ, tc.θ) || n != tc.n && !close(n, tc.n) || φ != tc.φ && !close(φ, tc.φ) {
+			t.Fatalf("modulus and phase(%v): expected %v, %v, %v, %v, got %v, %v, %v, %v", tc.x, tc.m, tc.θ, tc.n, tc.φ, m, θ, n, φ)
+		}
+	}
+	// the moduli and phases are defined for x ≤ 0
+	for _, x := range []float64{math.NaN(), 1e-300, 0.5, 5, inf} {
+		for _, f := range []func(float64) float64{AiryM, AiryTheta, AiryN, AiryPhi} {
+			if ζ := f(x); math.IsNaN(ζ) == false {
+				t.Fatalf("expected NaN for the argument %v, got %v", x, ζ)
+			}
+		}
+	}
+}
+
 func TestGi(t *testing.T) {
 	testCases := []struct {
 		num, den, res float64
@@ -605,6 +800,1785 @@ func TestHiInf(t *testing.T) {
 	}
 }
 
+func TestScorerComplex(t *testing.T) {
+	testCases := []struct {
+		z, gi, hi, gid, hid complex128
+	}{
+		// extended precision values
+		{0, 0.204975542482000245, 0.40995108496400049, 0.149429452451275453, 0.298858904902550905},
+		{0.5, 0.244721043276558198, 0.609555999826597296, 0.0198735536655759192, 0.524699010475016383},
+		{-2, -0.553251584197889689, 0.140948996241491201, 0.224679196931464743, 0.05411596998970478},
+		{3 + 4i, 0.0848894483712088391 - 0.0358620434767798704i, 0.951508346283382036 + 1.08723832600849199i, -0.0787867766320107251 - 0.0636547543763161428i, 0.866676014528368208 + 3.0635216416346921i},
+		{-5 + 1i, -0.610308983600195474 + 1.64920685086457961i, 0.0605843029193492024 + 0.0116769388317556542i, 3.89057178478834804 + 0.911255947251740207i, 0.0109151188371633708 + 0.00422588693582777938i},
+		{2i, 0.928815029230809255 - 0.256145820768492469i, 0.0565568929278871721 + 0.2437252654586673i, -0.978022190268081873 - 0.657877528147169774i, -0.089751809319131358 + 0.160005006358959602i},
+		{8 - 3i, 0.0348961219208412594 + 0.0132017100478107135i, -2.97016088014683807e5 - 4.4569767162719991e5i, -0.00326987477501560274 - 0.00292230334782401597i, -1.08327977569096239e6 - 1.11131790110180871e6i},
+		{-12 + 0.5i, -0.889956859008794092 - 0.177684395932895116i, 0.0264500088954947465 + 0.00109835171684065992i, -0.609015568097292268 + 2.81877975177214057i, 0.00218922282831738476 + 0.000181526374217037602i},
+		{1 + 15i, -5.68528425661136813e9 + 3.9662552678022308e9i, -0.0014204418478132027 + 0.0211234177855936917i, 2.65442553653166193e10 + 3.72989700005772657e9i, -0.00139487419653609054 - 0.000190079005507555363i},
+		{20, 0.0159194832005610377, 2.10376504965110381e25, -0.000796574007144951062, 9.38183933613396435e25},
+		{-20, -0.216050834636753584, 0.0159115253141022345, -0.792224016227585296, 0.000794982388048816644},
+		{6i, 158.721721066279124 + 94.7611700860940018i, -0.000141139398453941709 + 0.0530961383693374909i, -114.978809152645105 - 432.465606309190461i, -0.00835832834378584735 + 0.000439876596619094296i},
+		{0.3 - 0.2i, 0.241436043350738827 - 0.0124973994452020553i, 0.506602803945840864 - 0.0823195820140887773i, 0.0590415659902072718 + 0.0495423388874940478i, 0.403140055819768822 - 0.0933314125851330068i},
+		{4 + 7i, 0.0542601241595948357 - 0.21621916092812037i, -0.247920621415966047 + 0.232215713470025848i, -0.338090277608655152 + 0.40562012933632627i, -0.84770920922276769 + 0.147608599011755153i},
+		{0.598 + 11.574i, 4.09910980688221927e6 + 2.49212342601144865e5i, -0.0014515416189899079 + 0.0274212853455728282i, -9.54575916943370277e6 - 1.0135916516743153e7i, -0.0023539018449380414 - 0.000255855455447726321i},
+		{-13.579 + 5.136i, 1.34832901909096451e7 + 2.31188161242064774e7i, 0.0205056968663263399 + 0.00774242740586414901i, 7.74954526929468265e7 - 6.59203913282346483e7i, 0.00113309877780674854 + 0.000995632290275825234i},
+		{10.103 + 13.718i, 0.0111088879273504741 - 0.0150853577780085601i, -284.718466366207114 + 602.54881469841063i, 0.000109710068714199707 + 0.00114837052963544019i, -2175.409697745664 + 1680.88207180802359i},
+		{-0.193 - 8.972i, 6737.08980522120296 - 77663.577559883297i, 0.000665353105442359362 - 0.0354670226767415408i, 1.49955256623844036e5 + 1.76956047551644215e5i, -0.00394938366531343239 - 0.000126526193888126681i},
+		{-4.975 + 2.886i, 55.338287076462099 + 111.537586483252933i, 0.0481128395278465072 + 0.027252838640453863i, 223.69482484197878 - 192.204953551776437i, 0.00508030433663948094 + 0.00811020291732664402i},
+		{4.454 + 9.139i, 0.352405014983740075 - 1.51747769579252602i, -0.0368799924346977845 + 0.0511193318450165482i, -3.40218881551837098 + 3.48105325400181692i, -0.103743812957751766 + 0.0199351429370782071i},
+		{2.481 - 6.271i, -1.71514569087090186 - 1.38775774127228315i, -0.0148262735762748878 - 0.0164589345601265341i, 5.79254154696271133 + 0.620482257396569374i, 0.0410566019131014769 + 0.0588707932086889475i},
+	}
+
+	for _, tc := range testCases {
+		for _, c := range []struct {
+			name string
+			ζ, y complex128
+		}{
+			{"Gic", Gic(tc.z), tc.gi},
+			{"Hic", Hic(tc.z), tc.hi},
+			{"Gid", Gid(tc.z), tc.gid},
+			{"Hid", Hid(tc.z), tc.hid},
+		} {
+			if soclose(cmplx.Abs(c.ζ-c.y), 0, 1e-13*cmplx.Abs(c.y)) == false {
+				t.Errorf("%v(%v): expected %v, got %v", c.name, tc.z, c.y, c.ζ)
+			}
+		}
+	}
+}
+
+func TestScorerConsistency(t *testing.T) {
+	// the complex functions agree with the real functions on the real axis
+	for _, x := range []float64{-100, -8.25, -4, -1, -0.1, 0, 0.1, 1, 2.5, 7, 12, 30} {
+		if ζ := Gic(complex(x, 0)); imag(ζ) != 0 || soclose(real(ζ), Gi(x), 1e-12) == false {
+			t.Errorf("Gic(%v): expected %v, got %v", x, Gi(x), ζ)
+		}
+		if ζ := Hic(complex(x, 0)); imag(ζ) != 0 || soclose(real(ζ), Hi(x), 1e-13) == false {
+			t.Errorf("Hic(%v): expected %v, got %v", x, Hi(x), ζ)
+		}
+	}
+
+	// Gi(z) + Hi(z) = Bi(z) and Gi'(z) + Hi'(z) = Bi'(z)
+	for _, r := range []float64{0.5, 2, 6, 15} {
+		for k := 0; k < 24; k++ {
+			z := cmplx.Rect(r, float64(k)*math.Pi/12)
+			gi, hi, bi := Gic(z), Hic(z), Bi(z)
+			if soclose(cmplx.Abs(gi+hi-bi), 0, 1e-13*(cmplx.Abs(gi)+cmplx.Abs(hi))) == false {
+				t.Errorf("Gic(%v) + Hic(%v): expected %v, got %v", z, z, bi, gi+hi)
+			}
+			gid, hid, bid := Gid(z), Hid(z), Bid(z)
+			if soclose(cmplx.Abs(gid+hid-bid), 0, 1e-13*(cmplx.Abs(gid)+cmplx.Abs(hid))) == false {
+				t.Errorf("Gid(%v) + Hid(%v): expected %v, got %v", z, z, bid, gid+hid)
+			}
+			if ζ := Hic(cmplx.Conj(z)); ζ != cmplx.Conj(hi) {
+				t.Errorf("Hic(%v): expected %v, got %v", cmplx.Conj(z), cmplx.Conj(hi), ζ)
+			}
+		}
+	}
+}
+
+func TestScorerSpecial(t *testing.T) {
+	inf := math.Inf(1)
+	if ζ := Gic(complex(inf, 0)); ζ != 0 {
+		t.Errorf("Gic(+Inf): expected 0, got %v", ζ)
+	}
+	if ζ := Hic(complex(-inf, 0)); ζ != 0 {
+		t.Errorf("Hic(-Inf): expected 0, got %v", ζ)
+	}
+	if ζ := Hic(complex(inf, 0)); math.IsInf(real(ζ), 1) == false {
+		t.Errorf("Hic(+Inf): expected +Inf, got %v", ζ)
+	}
+	if ζ := Gid(cmplx.NaN()); cmplx.IsNaN(ζ) == false {
+		t.Errorf("Gid(NaN): expected NaN, got %v", ζ)
+	}
+}
+
+func TestBesselErr(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) (complex128, int, error)
+		α    float64
+		x    complex128
+		nz   int
+		err  error
+	}{
+		{"IE", IE, 0, 1000, 0, ErrOverflow},
+		{"IxE", IxE, 0, 1000, 0, nil},
+		{"KE", KE, 0, 1000, 1, nil},
+		{"KxE", KxE, 0, 1000, 0, nil},
+		{"JE", JE, 0, 1e8, 0, ErrPrecisionLoss},
+		{"JxE", JxE, 0, 1e10, 0, ErrTotalLoss},
+		{"YE", YE, 0, 1e10, 0, ErrTotalLoss},
+		{"YxE", YxE, 0, 1, 0, nil},
+		{"H1E", H1E, 0, 0, 0, ErrOverflow},
+		{"H1xE", H1xE, 0, 1, 0, nil},
+		{"H2E", H2E, 0, 1e10, 0, ErrTotalLoss},
+		{"H2xE", H2xE, 0, 0, 0, ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		_, nz, err := tc.f(tc.α, tc.x)
+		if nz != tc.nz {
+			t.Fatalf("%v(%v, %v): expected underflow count %v, got %v", tc.name, tc.α, tc.x, tc.nz, nz)
+		}
+		if err != tc.err {
+			t.Fatalf("%v(%v, %v): expected error %v, got %v", tc.name, tc.α, tc.x, tc.err, err)
+		}
+	}
+}
+
+func TestBesselE(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		fE   func(float64, complex128) (complex128, int, error)
+	}{
+		{"I", I, IE},
+		{"Ix", Ix, IxE},
+		{"J", J, JE},
+		{"Jx", Jx, JxE},
+		{"K", K, KE},
+		{"Kx", Kx, KxE},
+		{"Y", Y, YE},
+		{"Yx", Yx, YxE},
+		{"H1", H1, H1E},
+		{"H1x", H1x, H1xE},
+		{"H2", H2, H2E},
+		{"H2x", H2x, H2xE},
+	}
+
+	for _, tc := range testCases {
+		for _, α := range []float64{0, 0.25, 2.5, 13} {
+			for _, x := range []complex128{1, 0.4 + 0.1i, -1 - 1i, 30 + 2i} {
+				ζ, nz, err := tc.fE(α, x)
+				if err != nil || nz != 0 {
+					t.Fatalf("%vE(%v, %v): unexpected underflow count %v and error %v", tc.name, α, x, nz, err)
+				}
+				if y := tc.f(α, x); ζ != y {
+					t.Fatalf("%vE(%v, %v): expected %v, got %v", tc.name, α, x, y, ζ)
+				}
+			}
+		}
+	}
+}
+
+func TestBesselSeq(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		fSeq func(float64, int, complex128, []complex128) (int, error)
+		ν    float64
+	}{
+		{"I", I, ISeq, 0.25},
+		{"I", I, ISeq, -2.5},
+		{"Ix", Ix, IxSeq, 0.25},
+		{"J", J, JSeq, 0.25},
+		{"J", J, JSeq, -2.5},
+		{"Jx", Jx, JxSeq, 2},
+		{"K", K, KSeq, 0.25},
+		{"K", K, KSeq, -2.5},
+		{"Kx", Kx, KxSeq, 0.25},
+		{"Y", Y, YSeq, 0.25},
+		{"Y", Y, YSeq, -3},
+		{"Yx", Yx, YxSeq, 2},
+		{"H1", H1, H1Seq, 0.25},
+		{"H1", H1, H1Seq, -2.75},
+		{"H1x", H1x, H1xSeq, 0.25},
+		{"H2", H2, H2Seq, 0.25},
+		{"H2", H2, H2Seq, -2.75},
+		{"H2x", H2x, H2xSeq, 0.25},
+	}
+
+	const n = 8
+	for _, tc := range testCases {
+		for _, x := range []complex128{1, 0.4 + 0.1i, -1 - 1i, 30 + 2i} {
+			dst := make([]complex128, n)
+			if nz, err := tc.fSeq(tc.ν, n, x, dst); err != nil || nz != 0 {
+				t.Fatalf("%vSeq(%v, %v, %v): unexpected underflow count %v and error %v", tc.name, tc.ν, n, x, nz, err)
+			}
+			for k := 0; k < n; k++ {
+				y := tc.f(tc.ν+float64(k), x)
+				if soclose(cmplx.Abs(dst[k]-y), 0, 1e-13*cmplx.Abs(y)) == false {
+					t.Fatalf("%vSeq(%v, %v, %v)[%v]: expected %v, got %v", tc.name, tc.ν, n, x, k, y, dst[k])
+				}
+			}
+		}
+	}
+}
+
+func TestBesselSeqPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("JSeq did not panic")
+		}
+	}()
+	_, _ = JSeq(0, 3, 1, make([]complex128, 2))
+}
+
+func TestBesselNegativeOrder(t *testing.T) {
+	testCases := []struct {
+		α               float64
+		x, j, y, jx, yx complex128
+	}{
+		// extended precision values
+		{-0.75, 1, 0.044701115814504631055457726, 0.83475504835840586468277954, 0.044701115814504631055457726, 0.83475504835840586468277954},
+		{-1, 1, -0.44005058574493351595968220, 0.78121282130028871654715000, -0.44005058574493351595968220, 0.78121282130028871654715000},
+		{-2.5, 1.5 + 0.5i, 0.97998694968418352778896133 - 0.57376811055048566053546301i, 0.11135122134751473365964558 + 0.092593982590117642689644462i,
+			0.59439213110171913202726767 - 0.34800795061425725343090060i, 0.067537929743715581510138230 + 0.056161089345804147526382734i},
+		{-3, 2 + 1i, -0.082430798954355344806787869 - 0.17535344401066129113597112i, 0.57333925791071389996282629 - 0.51624670260929577734189311i,
+			-0.030324596254643756306591928 - 0.064508926990129875364397774i, 0.21091972580184287086732202 - 0.18991654846250750518356771i},
+		{-7.3, 4 - 2i, 1.3019949774617339669697980 - 0.59566963732444746563975762i, -0.91380926383097644201447340 + 0.44126382192065766233728443i,
+			0.17620585904743092454025835 - 0.080615119082754456947849284i, -0.12367063554480573073908317 + 0.059718564321702429018616062i},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64, complex128) complex128
+			y    complex128
+		}{{"J", J, tc.j}, {"Y", Y, tc.y}, {"Jx", Jx, tc.jx}, {"Yx", Yx, tc.yx}} {
+			ζ := f.f(tc.α, tc.x)
+			if close(real(ζ), real(f.y)) == false {
+				t.Fatalf("real(%v(%v, %v)): expected %v, got %v", f.name, tc.α, tc.x, real(f.y), real(ζ))
+			}
+			if close(imag(ζ), imag(f.y)) == false {
+				t.Fatalf("imag(%v(%v, %v)): expected %v, got %v", f.name, tc.α, tc.x, imag(f.y), imag(ζ))
+			}
+		}
+	}
+}
+
+func TestBesselReal(t *testing.T) {
+	testCases := []struct {
+		x                                                  float64
+		j0, j1, y0, y1, i0, i1, i0x, i1x, k0, k1, k0x, k1x float64
+	}{
+		// extended precision values
+		{0.1, 0.9975015620660400322812869, 0.04993752603624199755633655, -1.534238651350366844122399, -6.458951094702026987702053, 1.002501562934095601400211, 0.05006252604709269211380906, 0.9071009257823010964357263, 0.04529844680880932500710551, 2.427069024702016612518506, 9.853844780870606134848547, 2.682326102262894383081103, 10.89018268304969657420310},
+		{1, 0.7651976865579665514497175, 0.4400505857449335159596822, 0.08825696421567695798292677, -0.7812128213002887165471500, 1.266065877752008335598245, 0.5651591039924850272076960, 0.4657596075936404365019015, 0.2079104153497084488693547, 0.4210244382407083333356274, 0.6019072301972345747375400, 1.144463079806895014699041, 1.636153486263258246513311},
+		{2.5, -0.04838377646819799632728778, 0.4970941024642740380108163, 0.4980703596152318878274724, 0.1459181379667857988787599, 3.289839144050123035705908, 2.516716245288698441528192, 0.2700464416122027395600987, 0.2065846495312665542146437, 0.06234755320036618602916953, 0.07389081634774706364899354, 0.7595486903280995786937190, 0.9001744239078780891295610},
+		{3.9, -0.4018260148876399050347056, -0.02724403962077992625313237, 0.02337590819871892760297481, 0.4078200195265378964891138, 10.36895791673294398576414, 8.912787451362725689347793, 0.2098875279357806060891418, 0.1804118543257608814571125, 0.01248232275724977568420229, 0.01399928208227482804374061, 0.6166573147338331144961163, 0.6915988205835426687489576},
+		{4.1, -0.3886696798358536830293518, -0.1032732577473387017897141, -0.05609462660634461864967018, 0.3845940348189165383696690, 12.32357011601957143693358, 10.68774183641776123146770, 0.2042345273236375550467151, 0.1771244762327752147225655, 0.009980007227840242645672781, 0.01113627763347993155435325, 0.6021965063516314046113399, 0.6719661951682486187364191},
+		{7, 0.3000792705195555966502754, -0.004682823482345832699113806, -0.02594974396720926488428496, -0.3026672370241848700607682, 168.5939085102896988573266, 156.0390928699554534623906, 0.1537377446728812481528308, 0.1422892347095986744442477, 0.0004247957418692318068515987, 0.0004541824868848969712399594, 0.4658450960930158879161986, 0.4980715750954765402163278},
+		{8.5, 0.04193925184293450355176072, 0.2731219636740537442650038, 0.2702051053657874760039355, -0.02616867939853747002849528, 683.1619269901156092827174, 641.6199025400667607961074, 0.1390018430548475968152951, 0.1305493550945958550915117, 8.625756634932507761906924e-5, 9.119724775006898543584013e-5, 0.4239359993336980497489185, 0.4482133915630793892172580},
+		{20, 0.1670246643405831547273205, 0.06683312417585004557899297, 0.06264059680938383116172901, -0.1655116143625212958639760, 4.355828255955353327210666e7, 4.245497338512777018140991e7, 0.08978031188482602159594465, 0.08750622218328866535633007, 5.741237815336524292716702e-10, 5.883057969557038177650282e-10, 0.2785448766571822239331638, 0.2854254969407264451735292},
+		{100, 0.01998585030422312242422839, -0.07714535201411215803268549, -0.07724431336508315225422822, -0.02037231200275979330470393, 1.073751707131073823519721e42, 1.068369390338162481206146e42, 0.03994437929909668264755871, 0.03974415302513025267363893, 4.656628229175902018939005e-45, 4.679853735636909286562544e-45, 0.1251756216591265788915581, 0.1257999504795785293251039},
+		{1000, 0.02478668615242017456133073, 0.004728311907089523917576072, 0.004715917977622813399773261, -0.02478433129235177891486236, math.Inf(1), math.Inf(1), 0.01261724045589125658571613, 0.01261093025692862947023756, 0, 0, 0.03962832160075421711472592, 0.03964813081296021048014593},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64) float64
+			y    float64
+		}{{"J0", J0, tc.j0}, {"J1", J1, tc.j1}, {"Y0", Y0, tc.y0}, {"Y1", Y1, tc.y1},
+			{"I0", I0, tc.i0}, {"I1", I1, tc.i1}, {"I0x", I0x, tc.i0x}, {"I1x", I1x, tc.i1x},
+			{"K0", K0, tc.k0}, {"K1", K1, tc.k1}, {"K0x", K0x, tc.k0x}, {"K1x", K1x, tc.k1x}} {
+			// J and Y only have absolute accuracy near their zeros
+			if ζ := f.f(tc.x); close(ζ, f.y) == false && (f.name[0] == 'I' || f.name[0] == 'K' || math.Abs(ζ-f.y) > 1e-16) {
+				t.Fatalf("%v(%v): expected %v, got %v", f.name, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestBesselRealSpecial(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64) float64
+		x, y float64
+	}{
+		{"J0", J0, 0, 1},
+		{"J0", J0, -2.5, -0.04838377646819799632728778},
+		{"J1", J1, 0, 0},
+		{"J1", J1, -2.5, -0.4970941024642740380108163},
+		{"Y0", Y0, 0, math.Inf(-1)},
+		{"Y1", Y1, 0, math.Inf(-1)},
+		{"I0", I0, 0, 1},
+		{"I0", I0, -1, 1.266065877752008335598245},
+		{"I1", I1, -1, -0.5651591039924850272076960},
+		{"I0x", I0x, -1, 0.4657596075936404365019015},
+		{"I1x", I1x, -1, -0.2079104153497084488693547},
+		{"K0", K0, 0, math.Inf(1)},
+		{"K1", K1, 0, math.Inf(1)},
+		{"K0x", K0x, 0, math.Inf(1)},
+		{"K1x", K1x, 0, math.Inf(1)},
+		{"I0", I0, math.Inf(1), math.Inf(1)},
+		{"K0", K0, math.Inf(1), 0},
+		{"J0", J0, math.Inf(1), 0},
+	}
+
+	for _, tc := range testCases {
+		if ζ := tc.f(tc.x); close(ζ, tc.y) == false {
+			t.Fatalf("%v(%v): expected %v, got %v", tc.name, tc.x, tc.y, ζ)
+		}
+	}
+
+	for _, f := range []func(float64) float64{Y0, Y1, K0, K1, K0x, K1x} {
+		if ζ := f(-1); math.IsNaN(ζ) == false {
+			t.Fatalf("expected NaN for a negative argument, got %v", ζ)
+		}
+	}
+}
+
+func TestBesselRealComplex(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64) float64
+		g    func(float64, complex128) complex128
+		α    float64
+	}{
+		{"J0", J0, J, 0},
+		{"J1", J1, J, 1},
+		{"Y0", Y0, Y, 0},
+		{"Y1", Y1, Y, 1},
+		{"I0", I0, I, 0},
+		{"I1", I1, I, 1},
+		{"I0x", I0x, Ix, 0},
+		{"I1x", I1x, Ix, 1},
+		{"K0", K0, K, 0},
+		{"K1", K1, K, 1},
+		{"K0x", K0x, Kx, 0},
+		{"K1x", K1x, Kx, 1},
+	}
+
+	for _, tc := range testCases {
+		for x := 1e-3; x < 500; x *= 1.05 {
+			ζ := tc.f(x)
+			y := real(tc.g(tc.α, complex(x, 0)))
+			// J and Y only have absolute accuracy near their zeros
+			e := math.Abs(y)
+			if tc.name[0] == 'J' || tc.name[0] == 'Y' {
+				e = math.Max(e, 1/math.Sqrt(1+x))
+			}
+			if math.Abs(ζ-y) > 1e-13*e {
+				t.Fatalf("%v(%v): expected %v, got %v", tc.name, x, y, ζ)
+			}
+		}
+	}
+}
+
+func TestSpherical(t *testing.T) {
+	testCases := []struct {
+		n                     int
+		x, j, y, i, k, h1, h2 complex128
+	}{
+		// extended precision values
+		{0, 0.5, 0.958851077208406000547, -1.75516512378074543223, 1.04219061098749472324, 1.90547226473017993689, 0.958851077208406000547 - 1.75516512378074543223i, 0.958851077208406000547 + 1.75516512378074543223i},
+		{1, 1e-3, 0.000333333300000001190476, -1.00000049999987500001e6, 0.000333333366666667857143, 1.57079554192013570019e6, 0.000333333300000001190476 - 1.00000049999987500001e6i, 0.000333333300000001190476 + 1.00000049999987500001e6i},
+		{2, 0.4 + 0.1i, 0.00992339944232031513273 + 0.00521969263281750080856i, -32.9778841517501524553 + 28.9819561385340191834i, 0.0100767315058463858911 + 0.00544826715903327040701i,
+			48.0931228172172673277 - 44.6062342723435405349i, -28.9720327390916988683 - 32.9726644591173349545i, 28.9918795379763394985 + 32.9831038443829699561i},
+		{5, 3 + 4i, -0.337370080989929003209 - 0.235516201292897567204i, 0.246894185163013008695 - 0.371936696767261700156i, 0.186411713477632132872 - 0.156410798120594550705i,
+			0.0899311451858415614954 - 0.0647572292378065349629i, 0.0345666157773326969470 + 0.0113779838701154414905i, -0.709306777757190703365 - 0.482410386455910575899i},
+		{10, 10, 0.0646051544925642642714, -0.172453672088057848852, 5.46454165343072524418, 0.000990762291443906059506, 0.0646051544925642642714 - 0.172453672088057848852i, 0.0646051544925642642714 + 0.172453672088057848852i},
+		{3, -7 + 2i, 0.102842722987886234777 - 0.398212012228664719870i, 0.421183061545998085410 + 0.111725759552095971356i, 12.6467961811075417344 + 30.2070426487994875612i,
+			39.7307581725508173637 + 94.8984793721220825372i, -0.00888303656420973657937 + 0.0229710493173333655397i, 0.214568482539982206133 - 0.819395073774662805279i},
+		{20, 0.5 + 30i, 1.55678273547200734412e8 - 1.04590672326557566078e8i, 1.04590672326557566077e8 + 1.55678273547200734414e8i, -0.0154769012057238725118 - 0.0138541424498154673627i,
+			0.0170503446730574024145 - 0.0388482915320895264295i, -1.99065555502378562001e-12 - 1.41994108202742301347e-12i, 3.11356547094401468826e8 - 2.09181344653115132155e8i},
+		{25, 1.5, 8.29487645611658678782e-30, -1.57863777511834100505e27, 8.65459917532796566154e-30, 2.36842840500906167355e27, 8.29487645611658678782e-30 - 1.57863777511834100505e27i, 8.29487645611658678782e-30 + 1.57863777511834100505e27i},
+		{40, 20 - 3i, 1.08275898587336779747e-10 + 2.10309699104732883866e-10i, -1.60808664409639235705e6 + 2.47409118832781638306e6i, 2.47823971373543732657e-8 - 9.29934619951955501637e-9i,
+			27939.7926559747773528 + 16648.5503151212730202i, -2.47409118832781627478e6 - 1.60808664409639214674e6i, 2.47409118832781649133e6 + 1.60808664409639256736e6i},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(int, complex128) complex128
+			y    complex128
+		}{{"SphericalJ", SphericalJ, tc.j}, {"SphericalY", SphericalY, tc.y}, {"SphericalI", SphericalI, tc.i},
+			{"SphericalK", SphericalK, tc.k}, {"SphericalH1", SphericalH1, tc.h1}, {"SphericalH2", SphericalH2, tc.h2}} {
+			if ζ := f.f(tc.n, tc.x); soclose(cmplx.Abs(ζ-f.y), 0, 1e-14*cmplx.Abs(f.y)) == false {
+				t.Fatalf("%v(%v, %v): expected %v, got %v", f.name, tc.n, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestSphericalScaled(t *testing.T) {
+	testCases := []struct {
+		name   string
+		f, fx  func(int, complex128) complex128
+		factor func(complex128) complex128
+	}{
+		{"SphericalJx", SphericalJ, SphericalJx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(imag(z))), 0) }},
+		{"SphericalYx", SphericalY, SphericalYx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(imag(z))), 0) }},
+		{"SphericalIx", SphericalI, SphericalIx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(real(z))), 0) }},
+		{"SphericalKx", SphericalK, SphericalKx, func(z complex128) complex128 { return cmplx.Exp(z) }},
+		{"SphericalH1x", SphericalH1, SphericalH1x, func(z complex128) complex128 { return cmplx.Exp(-1i * z) }},
+		{"SphericalH2x", SphericalH2, SphericalH2x, func(z complex128) complex128 { return cmplx.Exp(1i * z) }},
+	}
+
+	for _, tc := range testCases {
+		for _, x := range []complex128{0.5, 0.4 + 0.1i, 3 + 4i, -7 + 2i, 20 - 3i} {
+			for _, n := range []int{0, 1, 5, 30} {
+				y := tc.factor(x) * tc.f(n, x)
+				if ζ := tc.fx(n, x); soclose(cmplx.Abs(ζ-y), 0, 1e-14*cmplx.Abs(y)) == false {
+					t.Fatalf("%v(%v, %v): expected %v, got %v", tc.name, n, x, y, ζ)
+				}
+			}
+		}
+	}
+
+	// large arguments where the unscaled functions overflow
+	for _, tc := range []struct {
+		name string
+		f    func(int, complex128) complex128
+		x, y complex128
+	}{
+		{"SphericalIx", SphericalIx, 1000, 0.999 / 2000},
+		{"SphericalKx", SphericalKx, 1000, math.Pi / 2 * 1.001e-3},
+		{"SphericalJx", SphericalJx, 1000i, 0.4995e-3i},
+		{"SphericalH1x", SphericalH1x, -1000i, -0.999e-3i},
+		{"SphericalH2x", SphericalH2x, 1000i, 0.999e-3i},
+	} {
+		if ζ := tc.f(1, tc.x); soclose(cmplx.Abs(ζ-tc.y), 0, 1e-14*cmplx.Abs(tc.y)) == false {
+			t.Fatalf("%v(1, %v): expected %v, got %v", tc.name, tc.x, tc.y, ζ)
+		}
+	}
+}
+
+func TestSphericalSeq(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(int, complex128) complex128
+		fSeq func(int, int, complex128, []complex128)
+	}{
+		{"SphericalJ", SphericalJ, SphericalJSeq},
+		{"SphericalJx", SphericalJx, SphericalJxSeq},
+		{"SphericalY", SphericalY, SphericalYSeq},
+		{"SphericalYx", SphericalYx, SphericalYxSeq},
+		{"SphericalI", SphericalI, SphericalISeq},
+		{"SphericalIx", SphericalIx, SphericalIxSeq},
+		{"SphericalK", SphericalK, SphericalKSeq},
+		{"SphericalKx", SphericalKx, SphericalKxSeq},
+		{"SphericalH1", SphericalH1, SphericalH1Seq},
+		{"SphericalH1x", SphericalH1x, SphericalH1xSeq},
+		{"SphericalH2", SphericalH2, SphericalH2Seq},
+		{"SphericalH2x", SphericalH2x, SphericalH2xSeq},
+	}
+
+	const m = 8
+	for _, tc := range testCases {
+		for _, x := range []complex128{1, 0.4 + 0.1i, -1 - 1i, 30 + 2i} {
+			for _, n := range []int{0, 3} {
+				dst := make([]complex128, m)
+				tc.fSeq(n, m, x, dst)
+				for k := 0; k < m; k++ {
+					if y := tc.f(n+k, x); soclose(cmplx.Abs(dst[k]-y), 0, 1e-14*cmplx.Abs(y)) == false {
+						t.Fatalf("%vSeq(%v, %v, %v)[%v]: expected %v, got %v", tc.name, n, m, x, k, y, dst[k])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSphericalCylindrical(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(int, complex128) complex128
+		g    func(float64, complex128) complex128
+	}{
+		{"SphericalJ", SphericalJ, J},
+		{"SphericalY", SphericalY, Y},
+		{"SphericalI", SphericalI, I},
+		{"SphericalK", SphericalK, K},
+		{"SphericalH1", SphericalH1, H1},
+		{"SphericalH2", SphericalH2, H2},
+	}
+
+	for _, tc := range testCases {
+		for _, x := range []complex128{0.7, 2 - 0.5i, 6 + 1i, -3 + 3i, 15} {
+			for n := 0; n < 20; n++ {
+				y := cmplx.Sqrt(math.Pi/(2*x)) * tc.g(float64(n)+0.5, x)
+				if ζ := tc.f(n, x); soclose(cmplx.Abs(ζ-y), 0, 1e-13*cmplx.Abs(y)) == false {
+					t.Fatalf("%v(%v, %v): expected %v, got %v", tc.name, n, x, y, ζ)
+				}
+			}
+		}
+	}
+}
+
+func TestSphericalZero(t *testing.T) {
+	for _, n := range []int{0, 1, 4} {
+		y := complex(0, 0)
+		if n == 0 {
+			y = 1
+		}
+		if ζ := SphericalJ(n, 0); ζ != y {
+			t.Fatalf("SphericalJ(%v, 0): expected %v, got %v", n, y, ζ)
+		}
+		if ζ := SphericalI(n, 0); ζ != y {
+			t.Fatalf("SphericalI(%v, 0): expected %v, got %v", n, y, ζ)
+		}
+		for _, f := range []func(int, complex128) complex128{SphericalY, SphericalK, SphericalH1, SphericalH2} {
+			if ζ := f(n, 0); cmplx.IsInf(ζ) == false {
+				t.Fatalf("expected Inf for a zero argument, got %v", ζ)
+			}
+		}
+	}
+}
+
+func TestSphericalOverflow(t *testing.T) {
+	// sin(z) overflows for |Im(z)| > 710 while the scaled functions remain finite
+	for _, n := range []int{0, 1, 4} {
+		for _, x := range []complex128{1000i, 800 - 800i, -750 + 750i} {
+			if ζ := SphericalJ(n, x); cmplx.IsInf(ζ) == false {
+				t.Fatalf("SphericalJ(%v, %v): expected Inf, got %v", n, x, ζ)
+			}
+			if ζ := SphericalI(n, -1i*x); cmplx.IsInf(ζ) == false {
+				t.Fatalf("SphericalI(%v, %v): expected Inf, got %v", n, -1i*x, ζ)
+			}
+			if ζ := SphericalJx(n, x); cmplx.IsInf(ζ) || cmplx.IsNaN(ζ) {
+				t.Fatalf("SphericalJx(%v, %v): expected a finite value, got %v", n, x, ζ)
+			}
+		}
+	}
+}
+
+func TestSphericalPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("SphericalJ did not panic")
+		}
+	}()
+	_ = SphericalJ(-1, 1)
+}
+
+func TestRiccati(t *testing.T) {
+	testCases := []struct {
+		n             int
+		x, ψ, χ, ξ, d complex128
+	}{
+		// extended precision values
+		{0, 1.5, 0.997494986604054430942, 0.0707372016677029100882, 0.997494986604054430942 - 0.0707372016677029100882i, 0.0709148443026524487890},
+		{1, 0.4 + 0.1i, 0.0494639295756070716968 + 0.0258724686999307374994i, 2.54646928200752335988 - 0.544032794246102545246i,
+			-0.494568864670495473550 - 2.52059681330759262238i, 4.62558418772483609257 - 1.19674203127955799648i},
+		{5, 3 + 4i, -0.0700454377981967408094 - 2.05602892783840871445i, -2.22842934255808582671 + 0.128233349649733065688i,
+			0.0581879118515363248791 + 0.172400414719677112260i, 0.533873202762314119869 - 1.27505245310943513484i},
+		{12, 10, 0.172159997449928060551, 4.01964248497849762829, 0.172159997449928060551 - 4.01964248497849762829i, 0.866357772585413317613},
+		{3, -7 + 2i, 0.0765249635421257963025 + 2.99316953157642550864i, 3.17173294992617854058 - 0.0602858062273243713264i,
+			0.0162391573148014249761 - 0.178563418349753031937i, -0.0483890372869891365744 - 0.942281414434766306535i},
+		{20, 13.3 + 0.1i, 0.00347037637416681991597 + 0.000430792793673176708522i, 121.531534346147878359 - 13.4658078545322774527i,
+			-13.4623374781581106328 - 121.531103553354205182i, 1.23497227307272953901 - 0.0150988946857540715137i},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(int, complex128) complex128
+			y    complex128
+		}{{"RiccatiPsi", RiccatiPsi, tc.ψ}, {"RiccatiChi", RiccatiChi, tc.χ}, {"RiccatiXi", RiccatiXi, tc.ξ}, {"RiccatiD", RiccatiD, tc.d}} {
+			if ζ := f.f(tc.n, tc.x); soclose(cmplx.Abs(ζ-f.y), 0, 1e-14*cmplx.Abs(f.y)) == false {
+				t.Fatalf("%v(%v, %v): expected %v, got %v", f.name, tc.n, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestRiccatiSeq(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(int, complex128) complex128
+		fSeq func(int, int, complex128, []complex128)
+	}{
+		{"RiccatiPsi", RiccatiPsi, RiccatiPsiSeq},
+		{"RiccatiChi", RiccatiChi, RiccatiChiSeq},
+		{"RiccatiXi", RiccatiXi, RiccatiXiSeq},
+		{"RiccatiD", RiccatiD, RiccatiDSeq},
+	}
+
+	const m = 8
+	for _, tc := range testCases {
+		for _, x := range []complex128{1, 0.4 + 0.1i, -1 - 1i, 30 + 2i} {
+			for _, n := range []int{0, 3} {
+				dst := make([]complex128, m)
+				tc.fSeq(n, m, x, dst)
+				for k := 0; k < m; k++ {
+					if y := tc.f(n+k, x); soclose(cmplx.Abs(dst[k]-y), 0, 1e-14*cmplx.Abs(y)) == false {
+						t.Fatalf("%vSeq(%v, %v, %v)[%v]: expected %v, got %v", tc.name, n, m, x, k, y, dst[k])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestRiccatiDMie(t *testing.T) {
+	// D(n,mx) for a refractive index m with absorption and a large size parameter x,
+	// where ψ itself overflows, must satisfy D(n,z) → -i as Im(z) → ∞ for fixed n
+	// and the upward recurrence D(k,z) = -k/z + 1/(k/z - D(k-1,z))
+	const N = 3000
+	for _, z := range []complex128{(1.33 + 0.05i) * 2000, (1.5 + 1i) * 1000, 30 + 1e4i} {
+		d := make([]complex128, N)
+		RiccatiDSeq(0, N, z, d)
+		for k := 1; k < N; k++ {
+			κ := complex(float64(k), 0) / z
+			if y := -κ + 1/(κ-d[k-1]); soclose(cmplx.Abs(d[k]-y), 0, 1e-13*cmplx.Abs(y)) == false {
+				t.Fatalf("RiccatiD(%v, %v): expected %v, got %v", k, z, y, d[k])
+			}
+		}
+	}
+	if ζ := RiccatiD(1, 30+1e4i); soclose(cmplx.Abs(ζ+1i), 0, 1e-7) == false {
+		t.Fatalf("RiccatiD(1, 30+1e4i): expected -i, got %v", ζ)
+	}
+}
+
+func TestBesselLargeOrder(t *testing.T) {
+	// orders above 85 are computed by the uniform asymptotic expansions
+	// including their analytic continuation to the left half plane
+	testCases := []struct {
+		name string
+		f    func(int, complex128) complex128
+		g    func(float64, complex128) complex128
+	}{
+		{"J", SphericalJ, J},
+		{"Y", SphericalY, Y},
+		{"I", SphericalI, I},
+		{"K", SphericalK, K},
+		{"H1", SphericalH1, H1},
+		{"H2", SphericalH2, H2},
+	}
+
+	for _, tc := range testCases {
+		for _, x := range []complex128{50, 100 + 100i, 300i, 30 - 200i, -50 + 100i, -80 - 20i, 500 + 10i} {
+			for _, n := range []int{86, 100, 300} {
+				y := tc.f(n, x) / cmplx.Sqrt(math.Pi/(2*x))
+				if ζ := tc.g(float64(n)+0.5, x); soclose(cmplx.Abs(ζ-y), 0, 1e-12*cmplx.Abs(y)) == false {
+					t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, float64(n)+0.5, x, y, ζ)
+				}
+			}
+		}
+	}
+}
+
+func TestBesselLargeOrderJY(t *testing.T) {
+	// extended precision values of J from its power series, for orders above 85
+	testCases := []struct {
+		α    float64
+		x, y complex128
+	}{
+		{90, 50, 3.4337332503236650859308555e-16},
+		{100, 120, 7.5737179130010698346886500e-02},
+		{100, 30 + 40i, 2.1101704704359294804214120e-18 - 3.8223593886610372601256112e-18i},
+		{100, -60 + 10i, 2.0591002268304553223173321e-14 - 1.6493831592670843753962897e-14i},
+		{300, 200, 1.3941183954632936224482364e-30},
+		{90, 150i, -1.6473412023418410904757690e+52},
+		{120, 100 - 100i, 7.9663003575089056962314240e+24 + 3.8760282771881836394053632e+25i},
+	}
+
+	for _, tc := range testCases {
+		if ζ := J(tc.α, tc.x); soclose(cmplx.Abs(ζ-tc.y), 0, 1e-12*cmplx.Abs(tc.y)) == false {
+			t.Errorf("J(%v, %v): expected %v, got %v", tc.α, tc.x, tc.y, ζ)
+		}
+	}
+
+	// the Wronskian J(ν+1,z) Y(ν,z) - J(ν,z) Y(ν+1,z) = 2/(πz), whose terms grow like
+	// exp(2|Im(z)|) off the real axis
+	for _, x := range []complex128{50, 120, 30 + 40i, -60 + 10i, 150i, 100 - 100i, -80 - 20i} {
+		for _, ν := range []float64{86.25, 100, 300.7} {
+			a, b := J(ν+1, x)*Y(ν, x), J(ν, x)*Y(ν+1, x)
+			w, e := a-b, 2/(math.Pi*x)
+			if soclose(cmplx.Abs(w-e), 0, 1e-12*math.Max(cmplx.Abs(a), cmplx.Abs(e))) == false {
+				t.Errorf("Wronskian of J and Y(%v, %v): expected %v, got %v", ν, x, e, w)
+			}
+		}
+	}
+}
+
+func TestZeros(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(float64, int) float64
+		ν    float64
+		k    int
+		x    float64
+	}{
+		{"JZero", JZero, 0, 1, 2.404825557695773},
+		{"JZero", JZero, 0, 2, 5.520078110286311},
+		{"JZero", JZero, 0, 100, 313.3742660775278},
+		{"JZero", JZero, 1, 1, 3.831705970207512},
+		{"JZero", JZero, 2.5, 1, 5.763459196894550},
+		{"JZero", JZero, 10, 1, 14.47550068655454},
+		{"YZero", YZero, 0, 1, 0.8935769662791675},
+		{"YZero", YZero, 1, 1, 2.197141326031017},
+		{"JdZero", JdZero, 0, 1, 3.831705970207512},
+		{"JdZero", JdZero, 1, 1, 1.841183781340659},
+		{"YdZero", YdZero, 0, 1, 2.197141326031017},
+		{"YdZero", YdZero, 1, 1, 3.683022856585178},
+	}
+
+	for _, tc := range testCases {
+		if x := tc.f(tc.ν, tc.k); close(x, tc.x) == false {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.ν, tc.k, tc.x, x)
+		}
+	}
+}
+
+func TestZerosInterlace(t *testing.T) {
+	// the positive zeros interlace as j'(ν,1) < y(ν,1) < y'(ν,1) < j(ν,1) < j'(ν,2) < ...
+	// and for ν = 0 as y(0,1) < y'(0,1) < j(0,1) < j'(0,1) < y(0,2) < ...
+	const n = 40
+	for _, ν := range []float64{0, 0.01, 0.5, 0.99, 1, 1.5, 7.5, 100, 1000} {
+		j, y, jd, yd := JZeros(ν, n), YZeros(ν, n), JdZeros(ν, n), YdZeros(ν, n)
+		var chain []float64
+		for k := 0; k < n; k++ {
+			if ν == 0 {
+				chain = append(chain, y[k], yd[k], j[k], jd[k])
+			} else {
+				chain = append(chain, jd[k], y[k], yd[k], j[k])
+			}
+			if r := real(J(ν, complex(j[k], 0))); soclose(r, 0, 1e-14) == false {
+				t.Errorf("J(%v, %v): expected 0, got %v", ν, j[k], r)
+			}
+		}
+		for i := 1; i < len(chain); i++ {
+			if chain[i] <= chain[i-1] {
+				t.Fatalf("zeros of order %v are not interlaced: %v, %v", ν, chain[i-1], chain[i])
+			}
+		}
+	}
+}
+
+func TestZerosPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("JZero did not panic")
+		}
+	}()
+	_ = JZero(1, 0)
+}
+
+func TestAiryZeros(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(int) float64
+		k    int
+		x    float64
+		tol  float64
+	}{
+		{"AiZero", AiZero, 1, -2.33810741045976704, 1e-14},
+		{"AiZero", AiZero, 2, -4.08794944413097062, 1e-14},
+		{"AiZero", AiZero, 50, -38.0210086772552544, 1e-14},
+		{"BiZero", BiZero, 1, -1.17371322270912792, 1e-14},
+		{"BiZero", BiZero, 10, -12.3864171385827387, 1e-14},
+		{"AidZero", AidZero, 1, -1.01879297164747109, 1e-14},
+		{"AidZero", AidZero, 3, -4.82009921117873564, 1e-14},
+		{"BidZero", BidZero, 1, -2.29443968261412325, 1e-14},
+		{"BidZero", BidZero, 50, -38.0208357409578821, 1e-14},
+		{"AidAtAiZero", AidAtAiZero, 1, 0.701210822720691362, 5e-14},
+		{"AidAtAiZero", AidAtAiZero, 2, -0.803111369654863964, 5e-14},
+		{"AidAtAiZero", AidAtAiZero, 10, -1.06779385915742783, 5e-14},
+		{"AiAtAidZero", AiAtAidZero, 1, 0.535656656015699861, 5e-14},
+		{"AiAtAidZero", AiAtAidZero, 3, 0.380406468628153282, 5e-14},
+		{"AiAtAidZero", AiAtAidZero, 50, -0.227588291018356817, 5e-14},
+	}
+
+	for _, tc := range testCases {
+		if x := tc.f(tc.k); soclose(x, tc.x, tc.tol) == false {
+			t.Errorf("%v(%v): expected %v, got %v", tc.name, tc.k, tc.x, x)
+		}
+	}
+
+	complexCases := []struct {
+		k int
+		z complex128
+	}{
+		{1, 0.977544886731620686 + 2.14129070603874458i},
+		{2, 1.89677501389533635 + 3.62729176435891941i},
+		{10, 6.33068856706306814 + 11.1585812267602533i},
+		{30, 13.4357374961646535 + 23.4048071835114420i},
+	}
+	for _, tc := range complexCases {
+		if z := BiComplexZero(tc.k); soclose(cmplx.Abs(z-tc.z), 0, 1e-14*cmplx.Abs(tc.z)) == false {
+			t.Errorf("BiComplexZero(%v): expected %v, got %v", tc.k, tc.z, z)
+		}
+	}
+}
+
+func TestAiryZerosInterlace(t *testing.T) {
+	// the zeros interlace as 0 > a'(1) > a(1) > a'(2) > a(2) > ... and 0 > b(1) > b'(1) > b(2) > ...
+	const n = 60
+	a, ad, b, bd := AiZeros(n), AidZeros(n), BiZeros(n), BidZeros(n)
+	chain := []float64{0}
+	for k := 0; k < n; k++ {
+		chain = append(chain, ad[k], a[k])
+	}
+	for i := 1; i < len(chain); i++ {
+		if chain[i] >= chain[i-1] {
+			t.Fatalf("zeros of Ai and Ai' are not interlaced: %v, %v", chain[i-1], chain[i])
+		}
+	}
+	if b[0] >= 0 {
+		t.Fatalf("the first zero of Bi is not negative: %v", b[0])
+	}
+	for k := 1; k < n; k++ {
+		if b[k] >= bd[k-1] || bd[k-1] >= b[k-1] {
+			t.Fatalf("zeros of Bi and Bi' are not interlaced: %v, %v, %v", b[k-1], bd[k-1], b[k])
+		}
+	}
+	for k, z := range BiComplexZeros(n) {
+		if r := Bi(z); soclose(cmplx.Abs(r), 0, 1e-13*cmplx.Abs(Bid(z))*cmplx.Abs(z)) == false {
+			t.Errorf("Bi(%v): expected 0, got %v", z, r)
+		}
+		if math.Abs(cmplx.Phase(z)-math.Pi/3) > 0.5/float64(k+1) {
+			t.Errorf("BiComplexZero(%v): %v is not near ph(z) = π/3", k+1, z)
+		}
+	}
+}
+
+func TestDerivative(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		α    float64
+		z    complex128
+		ζ    complex128
+	}{
+		{"Jd", Jd, 2.5, 3 + 1i, 0.221193891743567029 - 0.192499976897792125i},
+		{"Jd", Jd, -1.3, 0.5 - 2i, -0.920111987011293428 - 1.01580241873941404i},
+		{"Yd", Yd, 0.3, 2 - 1i, 0.357878855078433421 + 0.563925388098944457i},
+		{"Id", Id, 1.7, -1 + 2i, 0.363833656831669543 + 0.156492715203257434i},
+		{"Kd", Kd, 0.6, 1.5 + 0.5i, -0.220127710237909281 + 0.204984955227310387i},
+		{"H1d", H1d, 1.2, 4 + 2i, -0.0552861839831649071 + 0.0139555987148250219i},
+		{"H2d", H2d, -0.7, 2 + 3i, -1.71936197442189108 + 7.08368458846910925i},
+	}
+
+	for _, tc := range testCases {
+		if ζ := tc.f(tc.α, tc.z); soclose(cmplx.Abs(ζ-tc.ζ), 0, 1e-14*cmplx.Abs(tc.ζ)) == false {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.α, tc.z, tc.ζ, ζ)
+		}
+	}
+}
+
+func TestDerivativeWronskian(t *testing.T) {
+	for _, α := range []float64{0, 0.25, 1, 3.5, 20, -0.6, -2} {
+		for _, z := range []complex128{0.1, 1 + 1i, -2 + 0.5i, 7 - 3i, 40 + 2i} {
+			// W{J,Y} = 2/(πz), W{I,K} = -1/z, W{J,H1} = 2i/(πz) and W{J,H2} = -2i/(πz)
+			for _, w := range []struct {
+				name string
+				w, y complex128
+			}{
+				{"J,Y", J(α, z)*Yd(α, z) - Jd(α, z)*Y(α, z), 2 / (math.Pi * z)},
+				{"I,K", I(α, z)*Kd(α, z) - Id(α, z)*K(α, z), -1 / z},
+				{"J,H1", J(α, z)*H1d(α, z) - Jd(α, z)*H1(α, z), 2i / (math.Pi * z)},
+				{"J,H2", J(α, z)*H2d(α, z) - Jd(α, z)*H2(α, z), -2i / (math.Pi * z)},
+			} {
+				if soclose(cmplx.Abs(w.w-w.y), 0, 1e-12*cmplx.Abs(w.y)) == false {
+					t.Errorf("W{%v}(%v, %v): expected %v, got %v", w.name, α, z, w.y, w.w)
+				}
+			}
+		}
+	}
+}
+
+func TestDerivativeScaled(t *testing.T) {
+	testCases := []struct {
+		name   string
+		f, fx  func(float64, complex128) complex128
+		factor func(complex128) complex128
+	}{
+		{"Jdx", Jd, Jdx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(imag(z))), 0) }},
+		{"Ydx", Yd, Ydx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(imag(z))), 0) }},
+		{"Idx", Id, Idx, func(z complex128) complex128 { return complex(math.Exp(-math.Abs(real(z))), 0) }},
+		{"Kdx", Kd, Kdx, func(z complex128) complex128 { return cmplx.Exp(z) }},
+		{"H1dx", H1d, H1dx, func(z complex128) complex128 { return cmplx.Exp(-1i * z) }},
+		{"H2dx", H2d, H2dx, func(z complex128) complex128 { return cmplx.Exp(1i * z) }},
+	}
+
+	for _, tc := range testCases {
+		for _, z := range []complex128{0.5 + 0.5i, 3 - 2i, -4 + 6i} {
+			y := tc.factor(z) * tc.f(1.5, z)
+			if ζ := tc.fx(1.5, z); soclose(cmplx.Abs(ζ-y), 0, 1e-14*cmplx.Abs(y)) == false {
+				t.Errorf("%v(1.5, %v): expected %v, got %v", tc.name, z, y, ζ)
+			}
+		}
+	}
+	if ζ, _, err := KdxE(0, 1000); err != nil || soclose(real(ζ), -math.Sqrt(math.Pi/2000)*(1+3/8000.0), 1e-6) == false {
+		t.Errorf("Kdx(0, 1000): expected %v, got %v, %v", -math.Sqrt(math.Pi/2000), ζ, err)
+	}
+}
+
+func TestDerivativeOrigin(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) (complex128, int, error)
+		α    float64
+		ζ    complex128
+		err  error
+	}{
+		{"JdE", JdE, 0, 0, nil},
+		{"JdE", JdE, 1, 0.5, nil},
+		{"JdE", JdE, -1, -0.5, nil},
+		{"JdE", JdE, 2.5, 0, nil},
+		{"JdE", JdE, 0.5, cmplx.Inf(), ErrOverflow},
+		{"JdE", JdE, -1.5, cmplx.Inf(), ErrOverflow},
+		{"IdE", IdE, -1, 0.5, nil},
+		{"IdE", IdE, 3, 0, nil},
+		{"YdE", YdE, 1, cmplx.Inf(), ErrOverflow},
+		{"KdE", KdE, 0, cmplx.Inf(), ErrOverflow},
+		{"H1dE", H1dE, 2, cmplx.Inf(), ErrOverflow},
+	}
+
+	for _, tc := range testCases {
+		if ζ, _, err := tc.f(tc.α, 0); ζ != tc.ζ && !(cmplx.IsInf(ζ) && cmplx.IsInf(tc.ζ)) || err != tc.err {
+			t.Errorf("%v(%v, 0): expected %v, %v, got %v, %v", tc.name, tc.α, tc.ζ, tc.err, ζ, err)
+		}
+	}
+}
+
+func TestKelvin(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		ν, x                   float64
+		ber, bei, ker, kei     float64
+		berd, beid, kerd, keid float64
+	}{
+		{0, 1, 0.984381781213086884, 0.249566040036659721, 0.286706208728316046, -0.494994636518719900,
+			-0.0624457521790309602, 0.497396511468097327, -0.694603891100690521, 0.352369913336170534},
+		{0, 5, -6.23008247866635773, 0.116034381550200378, -0.0115117271994906625, 0.0111875865098696397,
+			-3.84533947326215452, -4.35414051484311092, 0.0171934038283931128, -0.000819986543630789468},
+		{1, 2, -0.997077651926428533, 0.299775437002033515, -0.230805929518122963, 0.0800493978070667353,
+			-0.720531515242890166, -0.305845383213799327, 0.287982685566338806, 0.0736325011472676000},
+		{2.5, 12, 437.420535319933890, -164.439428006634172, 8.19331989722158163e-5, -3.51221285777427810e-5,
+			411.106523666444773, 190.242353873145460, -8.69324417786836503e-5, -3.00554992056365249e-5},
+		{-0.5, 3, 1.10096890244924678, 1.55965963762121213, -0.0136330415713143029, -0.0856623785352170975,
+			-0.463139502961621687, 1.65382980362070661, -0.0486602590163274623, 0.0844895279872982601},
+		{0, 0.01, 0.999999999843750000, 2.49999999995659722e-5, 4.72112133562854127, -0.785255135732191529,
+			-6.24999999994574653e-8, 0.00499999999973958333, -99.9960733823770736, 0.0261055575939542497},
+	}
+
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64, float64) float64
+			y, m float64
+		}{
+			{"Ber", Ber, tc.ber, math.Hypot(tc.ber, tc.bei)},
+			{"Bei", Bei, tc.bei, math.Hypot(tc.ber, tc.bei)},
+			{"Ker", Ker, tc.ker, math.Hypot(tc.ker, tc.kei)},
+			{"Kei", Kei, tc.kei, math.Hypot(tc.ker, tc.kei)},
+			{"Berd", Berd, tc.berd, math.Hypot(tc.berd, tc.beid)},
+			{"Beid", Beid, tc.beid, math.Hypot(tc.berd, tc.beid)},
+			{"Kerd", Kerd, tc.kerd, math.Hypot(tc.kerd, tc.keid)},
+			{"Keid", Keid, tc.keid, math.Hypot(tc.kerd, tc.keid)},
+		} {
+			// the accuracy is relative to the magnitude of ber + i bei, ker + i kei and so on
+			if ζ := f.f(tc.ν, tc.x); soclose(ζ-f.y, 0, 1e-14*f.m) == false {
+				t.Errorf("%v(%v, %v): expected %v, got %v", f.name, tc.ν, tc.x, f.y, ζ)
+			}
+		}
+	}
+}
+
+func TestKelvinScaled(t *testing.T) {
+	testCases := []struct {
+		name  string
+		f, fx func(float64, float64) float64
+		sign  float64
+	}{
+		{"Berx", Ber, Berx, -1}, {"Beix", Bei, Beix, -1}, {"Kerx", Ker, Kerx, 1}, {"Keix", Kei, Keix, 1},
+		{"Berdx", Berd, Berdx, -1}, {"Beidx", Beid, Beidx, -1}, {"Kerdx", Kerd, Kerdx, 1}, {"Keidx", Keid, Keidx, 1},
+	}
+
+	for _, tc := range testCases {
+		for _, x := range []float64{0.5, 7, 40} {
+			y := math.Exp(tc.sign*x/math.Sqrt2) * tc.f(1.5, x)
+			if ζ := tc.fx(1.5, x); soclose(ζ, y, 1e-13) == false {
+				t.Errorf("%v(1.5, %v): expected %v, got %v", tc.name, x, y, ζ)
+			}
+		}
+		// the unscaled functions overflow or underflow
+		if ζ := tc.fx(0, 3000); math.IsNaN(ζ) || math.IsInf(ζ, 0) || ζ == 0 || math.Abs(ζ) > 1 {
+			t.Errorf("%v(0, 3000): expected a finite value, got %v", tc.name, ζ)
+		}
+	}
+}
+
+func TestKelvinSpecial(t *testing.T) {
+	if ζ := Ker(0, 0); math.IsInf(ζ, 1) == false {
+		t.Errorf("Ker(0, 0): expected +Inf, got %v", ζ)
+	}
+	if ζ := Kei(0, 0); ζ != -math.Pi/4 {
+		t.Errorf("Kei(0, 0): expected %v, got %v", -math.Pi/4, ζ)
+	}
+	if ζ := Ber(0, 0); ζ != 1 {
+		t.Errorf("Ber(0, 0): expected 1, got %v", ζ)
+	}
+	if ζ := Bei(1, -1); math.IsNaN(ζ) == false {
+		t.Errorf("Bei(1, -1): expected NaN, got %v", ζ)
+	}
+}
+
+func TestRatio(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		ν    float64
+		z    complex128
+		ζ    complex128
+	}{
+		{"RatioI", RatioI, 0.3, 2 + 1i, 0.647631823975257468 + 0.163731748707435049i},
+		{"RatioI", RatioI, 4.5, 0.5 - 3i, 0.0557043908662624938 - 0.289925450082290155i},
+		{"RatioI", RatioI, 12.2, -6 + 2i, -0.220027942114091096 + 0.0664103862601618173i},
+		{"RatioJ", RatioJ, 0.3, 2 + 1i, 0.576246247134940735 + 0.782088218851215731i},
+		{"RatioJ", RatioJ, 4.5, 0.5 - 3i, 0.0382216930103270702 - 0.258253315169728930i},
+		{"RatioJ", RatioJ, 1.7, -8 + 0.5i, -0.287896325121042409 + 2.14009432504652413i},
+		{"RatioK", RatioK, 0.3, 2 + 1i, 1.31192144391135052 - 0.151482580122201063i},
+		{"RatioK", RatioK, 4.5, 0.5 - 3i, 0.702456943768709043 + 2.44478602008086681i},
+		{"RatioK", RatioK, 10.3, -10, 0.381927549934732471 - 6.77036173081771438e-5i},
+		{"RatioK", RatioK, 10.3, -7 - 7i, 0.349037638823782588 + 0.246402159645545590i},
+		{"RatioK", RatioK, 20.3, -25 + 3i, 0.466233274737922485 - 0.0361980748030070211i},
+	}
+
+	for _, tc := range testCases {
+		if ζ := tc.f(tc.ν, tc.z); soclose(cmplx.Abs(ζ-tc.ζ), 0, 1e-14*cmplx.Abs(tc.ζ)) == false {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.ν, tc.z, tc.ζ, ζ)
+		}
+	}
+}
+
+func TestRatioLarge(t *testing.T) {
+	// for ν = 1/2 the ratios are elementary
+	//    I(3/2,x)/I(1/2,x) = coth(x) - 1/x
+	//    J(3/2,x)/J(1/2,x) = 1/x - cot(x)
+	//    K(3/2,z)/K(1/2,z) = 1 + 1/z
+	for _, x := range []float64{0.7, 30, 1e4, 1e6} {
+		y := 1/math.Tanh(x) - 1/x
+		if ζ := RatioI(0.5, complex(x, 0)); soclose(real(ζ), y, 1e-14) == false || imag(ζ) != 0 {
+			t.Errorf("RatioI(0.5, %v): expected %v, got %v", x, y, ζ)
+		}
+		// the error in the argument reduction of cot(x) grows like x
+		y = 1/x - 1/math.Tan(x)
+		if ζ := RatioJ(0.5, complex(x, 0)); soclose(real(ζ), y, 1e-14*(1+x)) == false {
+			t.Errorf("RatioJ(0.5, %v): expected %v, got %v", x, y, ζ)
+		}
+		for _, z := range []complex128{complex(x, 0), complex(-x, 1), complex(x, -x)} {
+			y := 1 + 1/z
+			if ζ := RatioK(0.5, z); soclose(cmplx.Abs(ζ-y), 0, 1e-14*cmplx.Abs(y)) == false {
+				t.Errorf("RatioK(0.5, %v): expected %v, got %v", z, y, ζ)
+			}
+		}
+	}
+
+	// the ratios remain finite where the functions overflow or underflow
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		g    func(float64, complex128) complex128
+		ν    float64
+		z    complex128
+	}{
+		{"RatioI", RatioI, I, 2.5, 1000},
+		{"RatioI", RatioI, I, 400, 0.5},
+		{"RatioK", RatioK, K, 2.5, 1000},
+		{"RatioK", RatioK, K, 400, 0.5 + 0.5i},
+		{"RatioK", RatioK, K, 500.3, -10 + 1i},
+	}
+	for _, tc := range testCases {
+		if q := tc.g(tc.ν+1, tc.z) / tc.g(tc.ν, tc.z); cmplx.IsNaN(q) == false {
+			t.Errorf("%v(%v, %v): expected the quotient of the functions to be NaN, got %v", tc.name, tc.ν, tc.z, q)
+		}
+		if ζ := tc.f(tc.ν, tc.z); cmplx.IsNaN(ζ) || cmplx.IsInf(ζ) || ζ == 0 {
+			t.Errorf("%v(%v, %v): expected a finite ratio, got %v", tc.name, tc.ν, tc.z, ζ)
+		}
+	}
+	// for large order I(ν+1,x)/I(ν,x) ≈ x/(2(ν+1)) and K(ν+1,x)/K(ν,x) ≈ 2ν/x
+	if ζ := RatioI(400, 0.5); soclose(real(ζ), 0.5/802, 1e-6) == false {
+		t.Errorf("RatioI(400, 0.5): expected %v, got %v", 0.5/802, ζ)
+	}
+	if ζ := RatioK(400, 0.5); soclose(real(ζ), 800/0.5, 1e-6) == false {
+		t.Errorf("RatioK(400, 0.5): expected %v, got %v", 800/0.5, ζ)
+	}
+	// K(ν+1,z)/K(ν,z) ≈ (ν + √(ν²+z²))/z, and the recurrence needs no storage in the order
+	z := 2 + 1i
+	y := (1e6 + cmplx.Sqrt(1e12+z*z)) / z
+	if ζ := RatioK(1e6, z); soclose(cmplx.Abs(ζ-y), 0, 1e-12*cmplx.Abs(y)) == false {
+		t.Errorf("RatioK(1e6, %v): expected %v, got %v", z, y, ζ)
+	}
+	small := testing.AllocsPerRun(2, func() { RatioK(0.3, z) })
+	if large := testing.AllocsPerRun(2, func() { RatioK(1e5+0.3, z) }); large > small {
+		t.Errorf("RatioK(1e5+0.3, %v): expected at most %v allocations, got %v", z, small, large)
+	}
+}
+
+func TestRatioAsymptotic(t *testing.T) {
+	// extended precision values from Hankel's expansions, the continued fraction of I and the
+	// forward recurrence of K
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		ν    float64
+		z    complex128
+		ζ    complex128
+	}{
+		{"RatioI", RatioI, 0, 1e6, 9.9999949999987499987499980e-1},
+		{"RatioI", RatioI, 0, 1e9, 9.9999999949999999987500000e-1},
+		{"RatioI", RatioI, 0, 1e15, 9.9999999999999950000000000e-1},
+		{"RatioI", RatioI, 3.7, 1e9, 9.9999999580000000671999983e-1},
+		{"RatioI", RatioI, 20000, 15000, 3.3332133359733590578361675e-1},
+		{"RatioI", RatioI, 20000, 50000, 6.7702434072482573891001283e-1},
+		{"RatioI", RatioI, 12000.5, 3000, 1.2309084714734004357008273e-1},
+		{"RatioI", RatioI, 20000, 15000 + 9000i, 3.6120625660396205860749e-1 + 1.5837742383085990920662e-1i},
+		{"RatioI", RatioI, 5, 30000 + 40000i, 9.9993399861390734382602e-1 + 8.7995247965147009541179e-5i},
+		{"RatioI", RatioI, 3, -30000 - 20000i, -9.9991923206359154654265e-1 - 5.3843047245677185536589e-5i},
+		{"RatioJ", RatioJ, 2.5, 30000 + 5i, 1.3069668129023239187288e-5 + 9.9997375945689514426659e-1i},
+		{"RatioK", RatioK, 0, 1e10, 1.0000000000499999999987500},
+		{"RatioK", RatioK, 0, 1e12, 1.0000000000004999999999999},
+		{"RatioK", RatioK, 0.3, 1e11, 1.0000000000079999999999920},
+		{"RatioK", RatioK, 2.5, 3e4, 1.0001000033332222222225926},
+		{"RatioK", RatioK, 100000.3, 20000, 1.0099050183845658645167950e+1},
+		{"RatioK", RatioK, 30000.25, 40000, 2.0000179999939994372201086},
+		{"RatioK", RatioK, 30000.25, 30000 - 20000i, 1.8509164616087704467592 + 7.3733651207393181967249e-1i},
+		{"RatioK", RatioK, 1.5, -20000 + 10000i, 9.9992000120001599887988e-1 - 3.9998399911996159868797e-5i},
+	}
+
+	for _, tc := range testCases {
+		if ζ := tc.f(tc.ν, tc.z); soclose(cmplx.Abs(ζ-tc.ζ), 0, 1e-14*cmplx.Abs(tc.ζ)) == false {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.ν, tc.z, tc.ζ, ζ)
+		}
+	}
+
+	// the error in the reduction of the phase grows like x
+	for _, tc := range []struct{ ν, x, y float64 }{
+		{0, 1e6, -2.1929728968218398277922225},
+		{3.2, 1e7, 3.7207906069689875895439157e-2},
+	} {
+		if ζ := RatioJ(tc.ν, complex(tc.x, 0)); soclose(real(ζ), tc.y, 1e-14*tc.x) == false {
+			t.Errorf("RatioJ(%v, %v): expected %v, got %v", tc.ν, tc.x, tc.y, ζ)
+		}
+	}
+
+	// 0 < I(ν+1,x)/I(ν,x) < 1 for x > 0
+	for _, ν := range []float64{0, 2.5, 1000} {
+		for _, x := range []float64{1e6, 1e9, 1e15} {
+			if ζ := RatioI(ν, complex(x, 0)); real(ζ) <= 0 || real(ζ) >= 1 || imag(ζ) != 0 {
+				t.Errorf("RatioI(%v, %v): expected a value in (0, 1), got %v", ν, x, ζ)
+			}
+		}
+	}
+}
+
+func TestRatioPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("RatioK did not panic")
+		}
+	}()
+	_ = RatioK(-1, 1)
+}
+
+func TestLog(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(float64, float64) float64
+		ν, x float64
+		y    float64
+	}{
+		{"LogK", LogK, 49.5, 1e-8, 1088.05861790394542},
+		{"LogK", LogK, 49.5, 1e4, -10004.2568852077583},
+		{"LogK", LogK, 20.5, 3, 31.7112911667110377},
+		{"LogK", LogK, 1.5, 2e10, -2.00000000116337077e10},
+		{"LogK", LogK, 0.5, 1e-300, 345.613555301751580},
+		{"LogK", LogK, 30.3, 1e-7, 580.961401155808398},
+		{"LogK", LogK, 7.3, 1e-60, 1020.04699049194191},
+		{"LogK", LogK, 50, 1e-5, 754.176229042293117},
+		{"LogK", LogK, 50, 30, 4.07364319756858742},
+		{"LogK", LogK, 2.3, 0.7, 1.78774504908356868},
+		{"LogI", LogI, 49.5, 2000, 1994.66798833962323},
+		{"LogI", LogI, 3.5, 1e5, 99993.3245387340102},
+		{"LogI", LogI, 1.5, 2e10, 1.99999999872215624e10},
+		{"LogI", LogI, 25.5, 40, 29.2713156530304033},
+		{"LogI", LogI, 30.3, 1e-9, -724.602352684322835},
+		{"LogI", LogI, 7.3, 1e-60, -1022.72801202065620},
+		{"LogI", LogI, 50, 1e-5, -758.781399228281228},
+		{"LogI", LogI, 2.3, 0.7, -3.36472700360521520},
+	}
+
+	for _, tc := range testCases {
+		if y := tc.f(tc.ν, tc.x); close(y, tc.y) == false {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.ν, tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestLogConsistency(t *testing.T) {
+	// compare with the logarithms of the scaled functions where they are representable,
+	// including orders either side of the switch to the Debye expansions
+	for _, ν := range []float64{0, 0.4, 1, 5.5, 19.9, 20, 35, 50} {
+		for _, x := range []float64{1e-3, 0.1, 1, 12, 100, 1e4} {
+			if k := Kx(ν, complex(x, 0)); real(k) > 0 && math.IsInf(real(k), 1) == false {
+				y := math.Log(real(k)) - x
+				if ζ := LogK(ν, x); soclose(ζ-y, 0, 1e-14*math.Max(1, math.Abs(y))) == false {
+					t.Errorf("LogK(%v, %v): expected %v, got %v", ν, x, y, ζ)
+				}
+			}
+			if i := Ix(ν, complex(x, 0)); real(i) > 0 && math.IsInf(real(i), 1) == false {
+				y := math.Log(real(i)) + x
+				if ζ := LogI(ν, x); soclose(ζ-y, 0, 1e-14*math.Max(1, math.Abs(y))) == false {
+					t.Errorf("LogI(%v, %v): expected %v, got %v", ν, x, y, ζ)
+				}
+			}
+		}
+	}
+
+	// the Matérn kernel x^ν K(ν,x) tends to 2^(ν-1) Γ(ν) as x → 0
+	for _, ν := range []float64{2.5, 20, 50} {
+		lg, _ := math.Lgamma(ν)
+		y := lg + (ν-1)*math.Ln2
+		if ζ := ν*math.Log(1e-12) + LogK(ν, 1e-12); close(ζ, y) == false {
+			t.Errorf("ν log(x) + LogK(%v, 1e-12): expected %v, got %v", ν, y, ζ)
+		}
+	}
+}
+
+func TestLogSpecial(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, float64) float64
+		ν, x float64
+		y    float64
+	}{
+		{"LogK", LogK, 1.5, 0, math.Inf(1)},
+		{"LogK", LogK, 1.5, math.Inf(1), math.Inf(-1)},
+		{"LogK", LogK, -2.5, 1, LogK(2.5, 1)},
+		{"LogI", LogI, 0, 0, 0},
+		{"LogI", LogI, 1.5, 0, math.Inf(-1)},
+		{"LogI", LogI, 1.5, math.Inf(1), math.Inf(1)},
+	}
+	for _, tc := range testCases {
+		if y := tc.f(tc.ν, tc.x); y != tc.y {
+			t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, tc.ν, tc.x, tc.y, y)
+		}
+	}
+	for _, x := range []float64{-1, math.NaN()} {
+		if y := LogK(1, x); math.IsNaN(y) == false {
+			t.Errorf("LogK(1, %v): expected NaN, got %v", x, y)
+		}
+		if y := LogI(1, x); math.IsNaN(y) == false {
+			t.Errorf("LogI(1, %v): expected NaN, got %v", x, y)
+		}
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("LogI did not panic")
+		}
+	}()
+	_ = LogI(-1, 1)
+}
+
+// ldexp returns m × 2^e for a complex mantissa m
+func ldexp(m complex128, e int) complex128 {
+	return complex(math.Ldexp(real(m), e), math.Ldexp(imag(m), e))
+}
+
+func TestFrexp(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		g    func(float64, complex128) (complex128, int)
+	}{
+		{"IFrexp", I, IFrexp},
+		{"JFrexp", J, JFrexp},
+		{"KFrexp", K, KFrexp},
+		{"YFrexp", Y, YFrexp},
+		{"H1Frexp", H1, H1Frexp},
+		{"H2Frexp", H2, H2Frexp},
+	}
+	for _, tc := range testCases {
+		for _, α := range []float64{0, 2.5, -1.3, 10.2} {
+			for _, z := range []complex128{0.5, 3 - 2i, -4 + 6i, 20i} {
+				y := tc.f(α, z)
+				m, e := tc.g(α, z)
+				if ζ := ldexp(m, e); soclose(cmplx.Abs(ζ-y), 0, 1e-14*cmplx.Abs(y)) == false {
+					t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, α, z, y, ζ)
+				}
+				if a := math.Max(math.Abs(real(m)), math.Abs(imag(m))); a < 0.5 || a >= 1 {
+					t.Errorf("%v(%v, %v): mantissa %v is not normalized", tc.name, α, z, m)
+				}
+			}
+		}
+	}
+
+	airyCases := []struct {
+		name string
+		f    func(complex128) complex128
+		g    func(complex128) (complex128, int)
+	}{
+		{"AiFrexp", Ai, AiFrexp},
+		{"AidFrexp", Aid, AidFrexp},
+		{"BiFrexp", Bi, BiFrexp},
+		{"BidFrexp", Bid, BidFrexp},
+	}
+	for _, tc := range airyCases {
+		for _, z := range []complex128{1.5, -3 + 2i, 5i, 12 - 1i} {
+			y := tc.f(z)
+			if ζ := ldexp(tc.g(z)); soclose(cmplx.Abs(ζ-y), 0, 1e-14*cmplx.Abs(y)) == false {
+				t.Errorf("%v(%v): expected %v, got %v", tc.name, z, y, ζ)
+			}
+		}
+	}
+}
+
+func TestFrexpLarge(t *testing.T) {
+	logFrexp := func(m complex128, e int) float64 {
+		return math.Log(cmplx.Abs(m)) + float64(e)*math.Ln2
+	}
+	ζ := 2.0 / 3 * math.Pow(200, 1.5)
+	testCases := []struct {
+		name string
+		y, ζ float64
+	}{
+		{"IFrexp(0, 1000)", LogI(0, 1000), logFrexp(IFrexp(0, 1000))},
+		{"KFrexp(300, 0.1)", LogK(300, 0.1), logFrexp(KFrexp(300, 0.1))},
+		{"IFrexp(300, 0.1)", LogI(300, 0.1), logFrexp(IFrexp(300, 0.1))},
+		{"BiFrexp(200)", math.Log(real(Bix(200))) + ζ, logFrexp(BiFrexp(200))},
+		{"AiFrexp(200)", math.Log(real(Aix(200))) - ζ, logFrexp(AiFrexp(200))},
+	}
+	for _, tc := range testCases {
+		if close(tc.ζ, tc.y) == false {
+			t.Errorf("log %v: expected %v, got %v", tc.name, tc.y, tc.ζ)
+		}
+	}
+
+	// the Wronskians I(ν,z)K(ν+1,z) + I(ν+1,z)K(ν,z) = 1/z and J(ν+1,z)Y(ν,z) - J(ν,z)Y(ν+1,z) = 2/(πz)
+	// combine functions that underflow with functions that overflow
+	ν := 300.25
+	for _, z := range []complex128{0.1, 0.1 + 0.05i, -0.1 + 0.01i, 3 + 4i} {
+		im, ie := IFrexp(ν, z)
+		i1m, i1e := IFrexp(ν+1, z)
+		km, ke := KFrexp(ν, z)
+		k1m, k1e := KFrexp(ν+1, z)
+		if w := ldexp(im*k1m, ie+k1e) + ldexp(i1m*km, i1e+ke); soclose(cmplx.Abs(w*z-1), 0, 1e-12) == false {
+			t.Errorf("W{I,K}(%v, %v): expected %v, got %v", ν, z, 1/z, w)
+		}
+		jm, je := JFrexp(ν, z)
+		j1m, j1e := JFrexp(ν+1, z)
+		ym, ye := YFrexp(ν, z)
+		y1m, y1e := YFrexp(ν+1, z)
+		if w := ldexp(j1m*ym, j1e+ye) - ldexp(jm*y1m, je+y1e); soclose(cmplx.Abs(w*z*math.Pi/2-1), 0, 1e-12) == false {
+			t.Errorf("W{J,Y}(%v, %v): expected %v, got %v", ν, z, 2/(math.Pi*z), w)
+		}
+	}
+}
+
+func TestFrexpSmall(t *testing.T) {
+	// extended precision values of the leading term (z/2)^ν / Γ(ν+1) of the series of I and J,
+	// where the scaled functions from AMOS underflow
+	testCases := []struct {
+		ν float64
+		z complex128
+		m complex128
+		e int
+	}{
+		{0.5, 1e-320, 5.6087404308371623430492388e-1, -531},
+		{2.5, 1e-200, 8.7255253170437194061486945e-1, -1665},
+		{30.5, 1e-20, 9.6160325730407090683778329e-1, -2167},
+	}
+	for _, tc := range testCases {
+		for _, f := range []struct {
+			name string
+			f    func(float64, complex128) (complex128, int)
+		}{{"IFrexp", IFrexp}, {"JFrexp", JFrexp}} {
+			if m, e := f.f(tc.ν, tc.z); e != tc.e || soclose(cmplx.Abs(m-tc.m), 0, 1e-14) == false {
+				t.Errorf("%v(%v, %v): expected %v, %v, got %v, %v", f.name, tc.ν, tc.z, tc.m, tc.e, m, e)
+			}
+		}
+	}
+	z := 1e-315 + 1e-315i
+	y := 2.7721256160130374442123831e-158 + 1.1482520267544708201973831e-158i
+	if m, e := IFrexp(0.5, z); soclose(cmplx.Abs(ldexp(m, e)-y), 0, 1e-14*cmplx.Abs(y)) == false {
+		t.Errorf("IFrexp(0.5, %v): expected %v, got %v", z, y, ldexp(m, e))
+	}
+}
+
+func TestFrexpSpecial(t *testing.T) {
+	if m, e := JFrexp(1, 0); m != 0 || e != 0 {
+		t.Errorf("JFrexp(1, 0): expected 0, 0, got %v, %v", m, e)
+	}
+	if m, e := IFrexp(0, 0); m != 0.5 || e != 1 {
+		t.Errorf("IFrexp(0, 0): expected 0.5, 1, got %v, %v", m, e)
+	}
+	if m, _ := KFrexp(1, 0); cmplx.IsInf(m) == false {
+		t.Errorf("KFrexp(1, 0): expected Inf, got %v", m)
+	}
+}
+
+func TestComplexOrder(t *testing.T) {
+	testCases := []struct {
+		ν, z       complex128
+		j, y, i, k complex128
+	}{
+		// extended precision values computed using Mathematica
+		{0.5 + 1i, 1.5, 1.11454282437447414 - 0.281481494840567729i, -0.351209580576068196 - 0.962843919275141771i, 1.59371161771246971 - 1.08142190825033683i, 0.169399797260653613 + 0.0467955152466113447i},
+		{0.5 + 1i, 0.3 + 2i, 0.599993542523242807 + 0.109430664147395727i, -0.392011942869338729 + 0.666171753399350259i, 0.150577632346977122 + 0.26471112425306858i, -0.564473315660300517 - 0.490548184225544973i},
+		{0.5 + 1i, -4 + 1i, -0.0657967586579727721 - 0.0751147102888395709i, -0.338146493378293764 - 0.685841569222264194i, 0.459901776970471915 + 0.311434673502633486i, -33.2199459330704485 - 22.7227953619014589i},
+		{2.3 - 0.7i, 12 - 7i, -8.54290343235142254 + 39.1345467675977997i, 39.1350970618186131 + 8.54308539702882566i, 12833.9747143904025 - 6018.3149866363245i, 1.43651475869690618e-6 + 2.0538709830379572e-6i},
+		{2.3 - 0.7i, -5 - 0.5i, -0.0265022108446159715 - 0.0625801295812045054i, 0.862954655198595037 + 0.306512939279960811i, 1.83196682181572245 - 0.176721928524271227i, -38.9920888813690776 + 34.5569642592309055i},
+		{2.3 - 0.7i, 25, -0.0684254862115182367 - 0.189322403801667787i, 0.244030557215360024 - 0.053221019933900748i, 5.22437264618334205e9 + 3.43497039011230491e8i, 3.79850326581702866e-12 - 2.39970309479684989e-13i},
+		{3i, 0.01i, -0.0747347125943207625 + 0.217868389962875584i, 0.653605175564021777 - 0.0747347125943207625i, -0.0747376069227935835 + 0.217866179584055261i, -0.684502028230396692 + 0.234776170531831073i},
+		{3i, 3 - 0.001i, 14.9629358155807943 + 15.6436019452219459i, 15.6461195066200441 - 14.9605273632233684i, 30.0180062960989008 - 17.2352125788114318i, 0.00873048029833242133 + 6.15411483794303546e-6i},
+		{3i, -2 - 3i, 6238.46519404203363 - 356.182757716097179i, -356.182751237150488 - 6238.46526303834099i, 7530.69144387456024 + 31828.5502828989696i, -8.06933652956956416 + 1.90921663936658973i},
+		{-1.7 + 0.4i, 1.5, -0.837888888663911764 - 0.703898893663255068i, -0.966667875387265609 + 0.712766997146146921i, -0.0347403449257377327 + 0.11733172423383704i, 0.406881052140300277 - 0.141636039155035142i},
+		{-1.7 + 0.4i, 0.3 + 2i, -0.38828484280741087 - 0.111294475472754512i, 0.34165044663412125 - 0.459704525324931451i, -0.117167514067194816 + 0.500288959324128029i, -0.583039149527792382 + 0.132955697057991099i},
+		{-1.7 + 0.4i, -4 + 1i, 0.18251420725077022 + 0.281262093042867161i, -0.10535072996292343 + 0.371375547936586013i, 2.07599178940806271 + 0.304490927839403252i, -16.5101967580000848 - 16.1833351980917829i},
+		{10 + 5i, 12 - 7i, -2790.81803947122504 + 2158.49705472130144i, 2158.49706015310719 + 2790.81803857294523i, 3307.14753596663967 + 7538.91611628710192i, 2.5378835204678216e-6 - 3.69829169436263026e-6i},
+		{10 + 5i, -5 - 0.5i, 20896.9074598983417 + 1603.9857109710643i, 1603.98570977950856 - 20896.9074590489387i, 57271.8998907298895 - 9235.61331214929998i, 0.00437323520890484155 + 0.0271148315076846611i},
+		{10 + 5i, 25, -22.6127104715931788 - 15.6855276517363079i, -15.6857693439587265 + 22.612285297118263i, -5.11706380836423988e8 - 1.1247794347967382e9i, -5.30199332495599087e-12 + 1.42632684544034746e-11i},
+		{30 + 2i, 0.01i, -2.49856950588819457e-104 - 1.60063277283989372e-103i, 5.76168647311798068e99 - 6.50956361739813346e100i, -2.4985638339609006e-104 - 1.60063022776926286e-103i, -9.05043937761717078e99 + 1.02252162725664194e101i},
+		{30 + 2i, 3 - 0.001i, 6.95785721875172587e-28 + 1.79048957285812449e-28i, -1.40612589682051546e25 + 4.64585400015621417e24i, 8.05910848987607118e-28 + 1.99310249650898658e-28i, 1.89928729586731027e25 - 6.04950875853491038e24i},
+		{30 + 2i, -2 - 3i, 2.44136390102966712e-24 - 1.46905791898724436e-23i, -1.57836139935093057e20 - 6.91773241486771135e20i, 4.93576387673351899e-24 - 1.30099986659993569e-23i, 5.05800914375690543e20 + 1.08519761348546612e21i},
+		{-6.5 - 1i, 1.5, -3334.93745010754298 + 6167.15649397382982i, -6144.16580895703886 - 3322.50506309481352i, -2916.43518219885783 + 4957.19385181486841i, -395.198761505429266 + 671.736835468018514i},
+		{-6.5 - 1i, 0.3 + 2i, 1479.0923818692496 - 2848.81613412192774i, 2838.19597297613497 + 1473.57845012899674i, 1356.01807740196783 - 4229.19445775786567i, 183.750578232082513 - 573.087480911845957i},
+		{-6.5 - 1i, -4 + 1i, 243.120215311933886 + 151.659036590120519i, -151.093545188187254 + 242.213732463971247i, 4.92520994205991359 + 92.1412347675406463i, 0.667393928503669553 + 12.4865695140315569i},
+		{0.1 - 8i, 12 - 7i, -7.29993265015765203 - 4.32669927001810017i, 4.32702139673465173 - 7.29748151758652038i, 76779.2511267141133 + 1.00348144919940154e5i, 2.93515421955410654e-7 - 6.62388124448572901e-8i},
+		{0.1 - 8i, -5 - 0.5i, 4.28524497728669051e-7 + 9.30547357147182855e-7i, -30440.2755449339857 - 12670.2276813957445i, -8.46290145179941221e-7 + 3.92036590846770921e-7i, 38436.5569280337105 - 75262.9144317843176i},
+		{0.1 - 8i, 25, -15171.2687030923436 + 15425.9130024730328i, -15425.9130028721469 - 15171.2687026989069i, 2.15927787849809759e10 + 7.1987310145162939e8i, 9.7697571416744057e-13 - 3.11702178219780547e-14i},
+	}
+
+	for _, tc := range testCases {
+		// the hankel functions are only compared relative to the magnitudes of J and Y, since
+		// J ± iY may cancel
+		jy := cmplx.Abs(tc.j) + cmplx.Abs(tc.y)
+		for _, c := range []struct {
+			name  string
+			ζ, y  complex128
+			scale float64
+		}{
+			{"Jc", Jc(tc.ν, tc.z), tc.j, cmplx.Abs(tc.j)},
+			{"Yc", Yc(tc.ν, tc.z), tc.y, cmplx.Abs(tc.y)},
+			{"Ic", Ic(tc.ν, tc.z), tc.i, cmplx.Abs(tc.i)},
+			{"Kc", Kc(tc.ν, tc.z), tc.k, cmplx.Abs(tc.k)},
+			{"H1c", H1c(tc.ν, tc.z), tc.j + 1i*tc.y, jy},
+			{"H2c", H2c(tc.ν, tc.z), tc.j - 1i*tc.y, jy},
+		} {
+			if soclose(cmplx.Abs(c.ζ-c.y), 0, 1e-13*c.scale) == false {
+				t.Errorf("%v(%v, %v): expected %v, got %v", c.name, tc.ν, tc.z, c.y, c.ζ)
+			}
+		}
+	}
+
+	// large orders, where J is the minimal solution of the recurrence in the order
+	largeCases := []struct {
+		ν, z complex128
+		j, i complex128
+	}{
+		// extended precision values computed using Mathematica
+		{50 + 3i, 30, -2.24903352954608062e-8 + 4.63889929576579174e-9i, -0.000117083732198505193 + 0.000105424879986192529i},
+		{100 + 1i, 60 + 5i, 5.0421061393767982e-15 - 4.37860854773828055e-15i, -1.89857528357124044e-7 + 3.09099857806554433e-7i},
+		{40 - 10i, 20, 2.4216930564719135e-9 + 3.12842807859753205e-9i, -1.80647060298843676e-7 + 3.53071760417741335e-7i},
+		{60 + 0.5i, 45 - 2i, -1.34692909224680632e-5 - 1.86331681825115454e-5i, -322.155212378439077 + 290.376219453953994i},
+		{15 + 1i, 3 + 2i, 2.71523063845351229e-9 + 5.54853818677141209e-10i, 2.79864472673522666e-9 + 1.77566939284302173e-9i},
+	}
+	for _, tc := range largeCases {
+		if ζ := Jc(tc.ν, tc.z); soclose(cmplx.Abs(ζ-tc.j), 0, 1e-13*cmplx.Abs(tc.j)) == false {
+			t.Errorf("Jc(%v, %v): expected %v, got %v", tc.ν, tc.z, tc.j, ζ)
+		}
+		if ζ := Ic(tc.ν, tc.z); soclose(cmplx.Abs(ζ-tc.i), 0, 1e-13*cmplx.Abs(tc.i)) == false {
+			t.Errorf("Ic(%v, %v): expected %v, got %v", tc.ν, tc.z, tc.i, ζ)
+		}
+	}
+}
+
+func TestComplexOrderConsistency(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func(float64, complex128) complex128
+		g    func(complex128, complex128) complex128
+	}{
+		{"Jc", J, Jc},
+		{"Yc", Y, Yc},
+		{"Ic", I, Ic},
+		{"Kc", K, Kc},
+		{"H1c", H1, H1c},
+		{"H2c", H2, H2c},
+	}
+	// the functions are continuous in the order and agree with the real order functions
+	for _, tc := range testCases {
+		for _, ν := range []float64{0, 0.5, -1.3, 7.2} {
+			for _, z := range []complex128{0.2, 3 - 2i, -4 + 6i, 20i} {
+				y := tc.f(ν, z)
+				if ζ := tc.g(complex(ν, 0), z); ζ != y {
+					t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, ν, z, y, ζ)
+				}
+				if ζ := tc.g(complex(ν, 1e-9), z); soclose(cmplx.Abs(ζ-y), 0, 1e-7*cmplx.Abs(y)) == false {
+					t.Errorf("%v(%v, %v): expected %v, got %v", tc.name, complex(ν, 1e-9), z, y, ζ)
+				}
+			}
+		}
+	}
+
+	// the Wronskians I(ν,z)K(ν+1,z) + I(ν+1,z)K(ν,z) = 1/z and J(ν+1,z)Y(ν,z) - J(ν,z)Y(ν+1,z) = 2/(πz),
+	// relative to the magnitudes of their terms
+	for _, ν := range []complex128{0.3 + 2i, -5.5 - 1i, 12 + 4i, -25 + 0.5i} {
+		for _, z := range []complex128{0.05 + 0.1i, 2 - 1i, -7 + 3i, 30 + 15i, -60 - 2i} {
+			a, b := Ic(ν, z)*Kc(ν+1, z), Ic(ν+1, z)*Kc(ν, z)
+			if w := a + b; soclose(cmplx.Abs(w-1/z), 0, 1e-13*(cmplx.Abs(a)+cmplx.Abs(b))) == false {
+				t.Errorf("W{I,K}(%v, %v): expected %v, got %v", ν, z, 1/z, w)
+			}
+			a, b = Jc(ν+1, z)*Yc(ν, z), Jc(ν, z)*Yc(ν+1, z)
+			if w := a - b; soclose(cmplx.Abs(w-2/(math.Pi*z)), 0, 1e-13*(cmplx.Abs(a)+cmplx.Abs(b))) == false {
+				t.Errorf("W{J,Y}(%v, %v): expected %v, got %v", ν, z, 2/(math.Pi*z), w)
+			}
+		}
+	}
+}
+
+func TestComplexOrderSpecial(t *testing.T) {
+	if ζ := Jc(1+1i, 0); ζ != 0 {
+		t.Errorf("Jc(1+1i, 0): expected 0, got %v", ζ)
+	}
+	if ζ := Ic(-1+1i, 0); cmplx.IsNaN(ζ) == false {
+		t.Errorf("Ic(-1+1i, 0): expected NaN, got %v", ζ)
+	}
+	if ζ := Kc(1+1i, 0); cmplx.IsNaN(ζ) == false {
+		t.Errorf("Kc(1+1i, 0): expected NaN, got %v", ζ)
+	}
+	if ζ := Yc(1i, cmplx.NaN()); cmplx.IsNaN(ζ) == false {
+		t.Errorf("Yc(1i, NaN): expected NaN, got %v", ζ)
+	}
+}
+
+func TestKit(t *testing.T) {
+	testCases := []struct {
+		τ, x, k, kx float64
+	}{
+		// extended precision values computed using Mathematica
+		{0, 1, 0.421024438240708333, 0.421024438240708333},
+		{1, 1, 0.289428037025992128, 1.39228702553073744},
+		{0.5, 3, 0.0334958525770149044, 0.0734657852396283352},
+		{3, 0.5, -0.0113625307524798695, -1.26485168138873350},
+		{10, 1, 1.12945508216818024e-7, 0.749463924941298955},
+		{10, 9.99, 9.86804904888571908e-8, 0.654806630954615311},
+		{10, 10.01, 9.78030969540702342e-8, 0.648984577358316231},
+		{20, 15, -4.56484896960468686e-15, -0.200997174158048848},
+		{5, 30, 1.41402614627268714e-14, 3.64248963421600284e-11},
+		{30, 0.01, -1.02074240359211694e-21, -0.298236960252023338},
+		{50, 20, 2.74079028298716985e-35, 0.352601903069532131},
+		{-2, 1e-5, -0.0426747866374570639, -0.987524120746829873},
+	}
+
+	for _, tc := range testCases {
+		if ζ := Kit(tc.τ, tc.x); close(ζ, tc.k) == false {
+			t.Errorf("Kit(%v, %v): expected %v, got %v", tc.τ, tc.x, tc.k, ζ)
+		}
+		if ζ := Kitx(tc.τ, tc.x); close(ζ, tc.kx) == false {
+			t.Errorf("Kitx(%v, %v): expected %v, got %v", tc.τ, tc.x, tc.kx, ζ)
+		}
+		if tc.τ != 0 {
+			if ζ := Kc(complex(0, tc.τ), complex(tc.x, 0)); soclose(cmplx.Abs(ζ-complex(tc.k, 0)), 0, 1e-12*math.Abs(tc.k)) == false {
+				t.Errorf("Kc(%v, %v): expected %v, got %v", complex(0, tc.τ), tc.x, tc.k, ζ)
+			}
+		}
+	}
+
+	for _, x := range []float64{0, -1, math.NaN()} {
+		if ζ := Kit(1, x); math.IsNaN(ζ) == false {
+			t.Errorf("Kit(1, %v): expected NaN, got %v", x, ζ)
+		}
+	}
+	if ζ := Kit(1, math.Inf(1)); ζ != 0 {
+		t.Errorf("Kit(1, Inf): expected 0, got %v", ζ)
+	}
+}
+
+func TestCross(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		ν, a, b            float64
+		jy, jyd, jdy, jdyd float64
+	}{
+		{0, 1, 2, 3.70778363504162933317e-01, 1.32800949722290895227e-01, -3.99497460935529347115e-01, 4.03445129988319606262e-01},
+		{0, 5, 5.000001, 1.27323941758898381801e-07, 1.27323929008666791862e-01, -1.27323954473452599645e-01, 1.27323941758899228834e-07},
+		{0, 30, 30.5, 1.00898732894454008779e-02, 1.83035099177886288746e-02, -1.86370745071724047692e-02, 1.00903405959786498375e-02},
+		{1, 0.1, 0.1000000001, 6.36619736374686474316e-10, 6.36619771730961581113e+00, -6.36619772367581315820e+00, -6.30253538374319812466e-08},
+		{1, 3, 7.5, -1.31771548318236092490e-01, -2.91280148231250549107e-02, 6.03423538745265428118e-02, -1.23357447788142240985e-01},
+		{0.3, 0.5, 40, 1.27690543325519767492e-01, -4.50778965120070193584e-02, -6.41397847075693833263e-02, 1.81340941175163722354e-01},
+		{2, 0.001, 0.002, 5.96830947069956319062e-01, 6.76408239566609722715e+02, -1.35281665818251349265e+03, -1.19366123762586712837e+06},
+		{2.5, 10, 10.25, 1.55664904847307109664e-02, 6.02795908214672077063e-02, -6.18191387143261425474e-02, 1.46189036007063552502e-02},
+		{10, 2, 3, 1.66949356340164256629e+00, 5.33744172327731103422e+00, -8.16566996685950918788e+00, -2.60655333316776705033e+01},
+		{10, 20, 20.001, 3.18301888913870680356e-05, 3.18293852120342876666e-02, -3.18309766818253916942e-02, 2.38730395392704850704e-05},
+		{20, 1, 30, 1.98746728432411410432e+20, 5.17148147672648935014e+21, -3.96970056242499734733e+21, -1.03293438280233421636e+23},
+	}
+
+	for _, tc := range testCases {
+		for _, c := range []struct {
+			name string
+			f    func(float64, float64, float64) float64
+			y    float64
+		}{
+			{"CrossJY", CrossJY, tc.jy},
+			{"CrossJYd", CrossJYd, tc.jyd},
+			{"CrossJdY", CrossJdY, tc.jdy},
+			{"CrossJdYd", CrossJdYd, tc.jdyd},
+		} {
+			if y := c.f(tc.ν, tc.a, tc.b); close(y, c.y) == false {
+				t.Errorf("%v(%v, %v, %v): expected %v, got %v", c.name, tc.ν, tc.a, tc.b, c.y, y)
+			}
+			// the cross products are invariant under ν → -ν
+			if y := c.f(-tc.ν, tc.a, tc.b); soclose(y, c.y, 1e-13) == false {
+				t.Errorf("%v(%v, %v, %v): expected %v, got %v", c.name, -tc.ν, tc.a, tc.b, c.y, y)
+			}
+		}
+	}
+}
+
+func TestCrossLarge(t *testing.T) {
+	// J(200,x) underflows and Y(200,x) overflows, but not their products
+	testCases := []struct {
+		name string
+		f    func(float64, float64, float64) float64
+		y    float64
+	}{
+		{"CrossJY", CrossJY, 2.54802782677468394747e+57},
+		{"CrossJYd", CrossJYd, 2.54790105609876037226e+59},
+		{"CrossJdY", CrossJdY, -5.09599163234349243855e+59},
+		{"CrossJdYd", CrossJdYd, -5.09573809417685772762e+61},
+	}
+
+	for _, tc := range testCases {
+		if y := tc.f(200, 1, 2); soclose(y, tc.y, 1e-13) == false {
+			t.Errorf("%v(200, 1, 2): expected %v, got %v", tc.name, tc.y, y)
+		}
+	}
+}
+
+func TestCrossConsistency(t *testing.T) {
+	// for ν = 1/2 the functions are elementary and
+	//    JY(½,a,b) = 2/π sin(b-a)/√(ab)
+	for _, a := range []float64{0.1, 1, 7.3, 50} {
+		for _, h := range []float64{0, 1e-9, 1e-3, 0.1, 0.49, 0.51, 2, 25} {
+			b := a + h
+			want := 2 / math.Pi * math.Sin(b-a) / math.Sqrt(a*b)
+			if y := CrossJY(0.5, a, b); soclose(y, want, 1e-14*math.Max(1, b)) == false {
+				t.Errorf("CrossJY(0.5, %v, %v): expected %v, got %v", a, b, want, y)
+			}
+			// JY(ν,a,b) = -JY(ν,b,a), and the Wronskian JYd(ν,a,a) = 2/(πa)
+			for _, ν := range []float64{0, 1.5, 12} {
+				if y, z := CrossJY(ν, a, b), CrossJY(ν, b, a); close(y, -z) == false {
+					t.Errorf("CrossJY(%v, %v, %v): expected %v, got %v", ν, a, b, -z, y)
+				}
+				if h == 0 {
+					if y := CrossJYd(ν, a, a); veryclose(y, 2/(math.Pi*a)) == false {
+						t.Errorf("CrossJYd(%v, %v, %v): expected %v, got %v", ν, a, a, 2/(math.Pi*a), y)
+					}
+				}
+			}
+		}
+	}
+
+	// the Taylor series and the products of the functions agree where they meet
+	for _, ν := range []float64{0, 1, 3.5, 40} {
+		for _, a := range []float64{0.7, 4, 30, 90} {
+			h := 0.5 / math.Max(1, ν/a)
+			for _, f := range []func(float64, float64, float64) float64{CrossJY, CrossJYd, CrossJdY, CrossJdYd} {
+				y, z := f(ν, a, a+h*(1-1e-15)), f(ν, a, a+h*(1+1e-15))
+				if soclose(y, z, 1e-12) == false {
+					t.Errorf("cross products of order %v at %v are discontinuous: %v, %v", ν, a, y, z)
+				}
+			}
+		}
+	}
+}
+
+func TestCrossSpecial(t *testing.T) {
+	nan := math.NaN()
+	for _, c := range [][3]float64{{nan, 1, 2}, {0, nan, 2}, {0, 1, nan}, {0, 0, 2}, {0, 1, -2}, {math.Inf(1), 1, 2}} {
+		if y := CrossJY(c[0], c[1], c[2]); math.IsNaN(y) == false {
+			t.Errorf("CrossJY(%v, %v, %v): expected NaN, got %v", c[0], c[1], c[2], y)
+		}
+	}
+	if y := CrossJdYd(1, 1, math.Inf(1)); y != 0 {
+		t.Errorf("CrossJdYd(1, 1, Inf): expected 0, got %v", y)
+	}
+}
+
+func TestCrossZeros(t *testing.T) {
+	// extended precision values
+	testCases := []struct {
+		name string
+		f    func(float64, float64, int) float64
+		ν, λ float64
+		k    int
+		x    float64
+	}{
+		{"CrossJYZero", CrossJYZero, 0, 2, 1, 3.12303091959569201208},
+		{"CrossJYZero", CrossJYZero, 3.5, 3, 3, 5.11100885587546560629},
+		{"CrossJYdZero", CrossJYdZero, 0, 2, 3, 7.81416275013190464449},
+		{"CrossJYdZero", CrossJYdZero, 0, 50, 1, 1.57890173658530724721e-02},
+		{"CrossJdYZero", CrossJdYZero, 1, 1.2, 2, 23.6808811454271754826},
+		{"CrossJdYZero", CrossJdYZero, 10, 1.5, 1, 9.28727597197793919293},
+		{"CrossJdYdZero", CrossJdYdZero, 1, 1.2, 1, 0.910331782773780684437},
+		{"CrossJdYdZero", CrossJdYdZero, 2, 1.01, 1, 1.99005795962123865905},
+		{"CrossJdYdZero", CrossJdYdZero, 10, 1.5, 1, 7.75972002627008450304},
+	}
+
+	for _, tc := range testCases {
+		if x := tc.f(tc.ν, tc.λ, tc.k); close(x, tc.x) == false {
+			t.Errorf("%v(%v, %v, %v): expected %v, got %v", tc.name, tc.ν, tc.λ, tc.k, tc.x, x)
+		}
+	}
+}
+
+func TestCrossZerosSign(t *testing.T) {
+	// the cross products change sign at each zero and nowhere between them
+	const n = 12
+	testCases := []struct {
+		name  string
+		f     func(float64, float64, float64) float64
+		zeros func(float64, float64, int) []float64
+	}{
+		{"CrossJY", CrossJY, CrossJYZeros},
+		{"CrossJYd", CrossJYd, CrossJYdZeros},
+		{"CrossJdY", CrossJdY, CrossJdYZeros},
+		{"CrossJdYd", CrossJdYd, CrossJdYdZeros},
+	}
+
+	for _, tc := range testCases {
+		for _, ν := range []float64{0, 0.5, 1, 7.5, 40} {
+			for _, λ := range []float64{1.05, 1.5, 4, 30} {
+				zeros := tc.zeros(ν, λ, n)
+				x0 := math.Max(ν/λ, 1e-3/λ)
+				for k, x := range zeros {
+					lo, hi := tc.f(ν, x*(1-1e-11), λ*x*(1-1e-11)), tc.f(ν, x*(1+1e-11), λ*x*(1+1e-11))
+					if x <= x0 || (lo < 0) == (hi < 0) {
+						t.Fatalf("%v(%v, x, %vx): %v is not zero %v", tc.name, ν, λ, x, k+1)
+					}
+					// sample between the zeros, which are spaced about π/(λ-1) apart
+					for i := 1; i < 8; i++ {
+						s := x0 + float64(i)/8*(x-x0)
+						if m := tc.f(ν, s, λ*s); (m < 0) != (lo < 0) {
+							t.Fatalf("%v(%v, x, %vx): a zero between %v and %v was missed", tc.name, ν, λ, x0, x)
+						}
+					}
+					x0 = x * (1 + 1e-11)
+				}
+			}
+		}
+	}
+}
+
+func TestCrossZerosPanic(t *testing.T) {
+	for _, f := range []func(){
+		func() { CrossJYZero(0, 2, 0) },
+		func() { CrossJYZero(-1, 2, 1) },
+		func() { CrossJdYdZeros(0, 1, 1) },
+		func() { CrossJYdZeros(0, 2, -1) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("cross product zeros did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
 // The floating point comparison tests are copied from from math/all_test.go.
 func tolerance(a, b, e float64) bool {
 	// Multiplying by e here can underflow denormal values to zero.
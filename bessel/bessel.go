@@ -12,160 +12,440 @@ import (
 
 // I computes the bessel function I for complex arguments
 func I(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	var factor complex128
-	if α < 0 {
-		factor = complex(2*math.Sin(math.Pi*math.Abs(α))/math.Pi, 0) * K(math.Abs(α), z)
-	} else {
-		factor = complex(0, 0)
-	}
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESI(real(z), imag(z), math.Abs(α), 1, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1]) + factor
+	ζ, _, _ := IE(α, z)
+	return ζ
+}
+
+// IE computes the bessel function I for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func IE(α float64, z complex128) (complex128, int, error) {
+	return besi(α, z, 1)
 }
 
 // Ix computes the expontentially scaled bessel function I for complex arguments
 func Ix(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
+	ζ, _, _ := IxE(α, z)
+	return ζ
+}
+
+// IxE computes the expontentially scaled bessel function I for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func IxE(α float64, z complex128) (complex128, int, error) {
+	return besi(α, z, 2)
+}
+
+// ISeq computes the n member sequence of bessel functions I of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func ISeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besi, zbesi)
+}
+
+// IxSeq computes the n member sequence of expontentially scaled bessel functions I of orders
+// ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func IxSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besi, zbesi)
+}
+
+func besi(α float64, z complex128, kode int) (complex128, int, error) {
 	var factor complex128
+	var err error
 	if α < 0 {
-		factor = complex(2*math.Sin(math.Pi*math.Abs(α))/math.Pi, 0) * Kx(math.Abs(α), z) * cmplx.Exp(-z+complex(-math.Abs(real(z)), 0))
+		var k complex128
+		k, _, err = besk(math.Abs(α), z, kode)
+		factor = complex(2*math.Sin(math.Pi*math.Abs(α))/math.Pi, 0) * k
+		if kode == 2 {
+			factor *= cmplx.Exp(-z + complex(-math.Abs(real(z)), 0))
+		}
 	} else {
 		factor = complex(0, 0)
 	}
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESI(real(z), imag(z), math.Abs(α), 2, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1]) + factor
+	var cy [1]complex128
+	NZ, e := zbesi(math.Abs(α), z, kode, 1, cy[:])
+	return cy[0] + factor, NZ, worst(e, err)
+}
+
+// zbesi computes I of orders ν, ..., ν+n-1 >= 0 into dst using a single AMOS call
+func zbesi(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
+	var NZ, IERR int
+	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESI(real(z), imag(z), ν, kode, n, CYR, CYI, NZ, IERR)
+	return NZ, unpack(CYR, CYI, IERR, dst)
 }
 
 // J computes the bessel function J for complex arguments
 func J(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESJ(real(z), imag(z), α, 1, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1])
+	ζ, _, _ := JE(α, z)
+	return ζ
+}
+
+// JE computes the bessel function J for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func JE(α float64, z complex128) (complex128, int, error) {
+	return besj(α, z, 1)
 }
 
 // Jx computes the expontentially scaled bessel function J for complex arguments
 func Jx(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
+	ζ, _, _ := JxE(α, z)
+	return ζ
+}
+
+// JxE computes the expontentially scaled bessel function J for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func JxE(α float64, z complex128) (complex128, int, error) {
+	return besj(α, z, 2)
+}
+
+// JSeq computes the n member sequence of bessel functions J of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func JSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besj, zbesj)
+}
+
+// JxSeq computes the n member sequence of expontentially scaled bessel functions J of orders
+// ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func JxSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besj, zbesj)
+}
+
+func besj(α float64, z complex128, kode int) (complex128, int, error) {
+	var cy [1]complex128
+	if α < 0 {
+		// J(-ν,z) = cos(νπ) J(ν,z) - sin(νπ) Y(ν,z)
+		return reflect(-α, z, kode, 1)
+	}
+	NZ, err := zbesj(α, z, kode, 1, cy[:])
+	return cy[0], NZ, err
+}
+
+// zbesj computes J of orders ν, ..., ν+n-1 >= 0 into dst using a single AMOS call
+func zbesj(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
 	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESJ(real(z), imag(z), α, 2, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1])
+	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESJ(real(z), imag(z), ν, kode, n, CYR, CYI, NZ, IERR)
+	return NZ, unpack(CYR, CYI, IERR, dst)
 }
 
 // K computes the bessel function K for complex arguments
 func K(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESK(real(z), imag(z), math.Abs(α), 1, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1])
+	ζ, _, _ := KE(α, z)
+	return ζ
+}
+
+// KE computes the bessel function K for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func KE(α float64, z complex128) (complex128, int, error) {
+	return besk(α, z, 1)
 }
 
 // Kx computes the expontentially scaled bessel function K for complex arguments
 func Kx(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
+	ζ, _, _ := KxE(α, z)
+	return ζ
+}
+
+// KxE computes the expontentially scaled bessel function K for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func KxE(α float64, z complex128) (complex128, int, error) {
+	return besk(α, z, 2)
+}
+
+// KSeq computes the n member sequence of bessel functions K of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func KSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besk, zbesk)
+}
+
+// KxSeq computes the n member sequence of expontentially scaled bessel functions K of orders
+// ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func KxSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besk, zbesk)
+}
+
+func besk(α float64, z complex128, kode int) (complex128, int, error) {
+	var cy [1]complex128
+	NZ, err := zbesk(math.Abs(α), z, kode, 1, cy[:])
+	return cy[0], NZ, err
+}
+
+// zbesk computes K of orders ν, ..., ν+n-1 >= 0 into dst using a single AMOS call
+func zbesk(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
 	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESK(real(z), imag(z), math.Abs(α), 2, 1, CYR, CYI, NZ, IERR)
-	return complex(CYR[1], CYI[1])
+	_, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESK(real(z), imag(z), ν, kode, n, CYR, CYI, NZ, IERR)
+	return NZ, unpack(CYR, CYI, IERR, dst)
 }
 
 // Y computes the bessel function Y for complex arguments
 func Y(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	CWRKR := []float64{math.NaN(), 0}
-	CWRKI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, _, _, IERR = amos.ZBESY(real(z), imag(z), α, 1, 1, CYR, CYI, NZ, CWRKR, CWRKI, IERR)
-	return complex(CYR[1], CYI[1])
+	ζ, _, _ := YE(α, z)
+	return ζ
+}
+
+// YE computes the bessel function Y for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func YE(α float64, z complex128) (complex128, int, error) {
+	return besy(α, z, 1)
 }
 
 // Yx computes the expontentially scaled bessel function Y for complex arguments
 func Yx(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	CWRKR := []float64{math.NaN(), 0}
-	CWRKI := []float64{math.NaN(), 0}
+	ζ, _, _ := YxE(α, z)
+	return ζ
+}
+
+// YxE computes the expontentially scaled bessel function Y for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func YxE(α float64, z complex128) (complex128, int, error) {
+	return besy(α, z, 2)
+}
+
+// YSeq computes the n member sequence of bessel functions Y of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func YSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besy, zbesy)
+}
+
+// YxSeq computes the n member sequence of expontentially scaled bessel functions Y of orders
+// ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func YxSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besy, zbesy)
+}
+
+func besy(α float64, z complex128, kode int) (complex128, int, error) {
+	var cy [1]complex128
+	if α < 0 {
+		// Y(-ν,z) = sin(νπ) J(ν,z) + cos(νπ) Y(ν,z)
+		return reflect(-α, z, kode, 2)
+	}
+	NZ, err := zbesy(α, z, kode, 1, cy[:])
+	return cy[0], NZ, err
+}
+
+// reflect computes J(-ν,z) for kind 1 or Y(-ν,z) for kind 2 with ν > 0 from J(ν,z) and Y(ν,z).
+// Both scaled functions share the factor exp(-|Im(z)|), so the formulas hold for KODE=2 too.
+// For integer ν the Y (respectively J) term vanishes and is not computed.
+func reflect(ν float64, z complex128, kode int, kind int) (complex128, int, error) {
+	var cj, cy [1]complex128
+	var nz, NZ int
+	var err, e error
+	s, c := sincospi(ν)
+	if kind == 2 {
+		// Y(-ν,z) = c J - s Y with c = sin(νπ) and s = -cos(νπ)
+		s, c = -c, s
+	}
+	if c != 0 {
+		nz, err = zbesj(ν, z, kode, 1, cj[:])
+	}
+	if s != 0 {
+		NZ, e = zbesy(ν, z, kode, 1, cy[:])
+		nz += NZ
+		err = worst(err, e)
+	}
+	return complex(c, 0)*cj[0] - complex(s, 0)*cy[0], nz, err
+}
+
+// sincospi returns sin(νπ) and cos(νπ), exact when 2ν is an integer
+func sincospi(ν float64) (float64, float64) {
+	r := math.Mod(ν, 2)
+	switch r {
+	case 0:
+		return 0, 1
+	case 0.5:
+		return 1, 0
+	case 1:
+		return 0, -1
+	case 1.5:
+		return -1, 0
+	}
+	return math.Sincos(math.Pi * r)
+}
+
+// zbesy computes Y of orders ν, ..., ν+n-1 >= 0 into dst using a single AMOS call
+func zbesy(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
+	CWRKR := make([]float64, n+1)
+	CWRKI := make([]float64, n+1)
 	var NZ, IERR int
-	_, _, _, _, _, CYR, CYI, NZ, _, _, IERR = amos.ZBESY(real(z), imag(z), α, 2, 1, CYR, CYI, NZ, CWRKR, CWRKI, IERR)
-	return complex(CYR[1], CYI[1])
+	_, _, _, _, _, CYR, CYI, NZ, _, _, IERR = amos.ZBESY(real(z), imag(z), ν, kode, n, CYR, CYI, NZ, CWRKR, CWRKI, IERR)
+	return NZ, unpack(CYR, CYI, IERR, dst)
 }
 
 // H1 computes the hankel function of order 1 for complex arguments
 func H1(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	var factor complex128
+	ζ, _, _ := H1E(α, z)
+	return ζ
+}
+
+// H1E computes the hankel function of order 1 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H1E(α float64, z complex128) (complex128, int, error) {
+	return besh(α, z, 1, 1)
+}
+
+// H1x computes the expontentially scaled hankel function of order 1 for complex arguments
+func H1x(α float64, z complex128) complex128 {
+	ζ, _, _ := H1xE(α, z)
+	return ζ
+}
+
+// H1xE computes the expontentially scaled hankel function of order 1 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H1xE(α float64, z complex128) (complex128, int, error) {
+	return besh(α, z, 2, 1)
+}
+
+// H2 computes the hankel function of order 2 for complex arguments
+func H2(α float64, z complex128) complex128 {
+	ζ, _, _ := H2E(α, z)
+	return ζ
+}
+
+// H2E computes the hankel function of order 2 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H2E(α float64, z complex128) (complex128, int, error) {
+	return besh(α, z, 1, 2)
+}
+
+// H2x computes the expontentially scalled hankel function of order 2 for complex arguments
+func H2x(α float64, z complex128) complex128 {
+	ζ, _, _ := H2xE(α, z)
+	return ζ
+}
+
+// H2xE computes the expontentially scalled hankel function of order 2 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H2xE(α float64, z complex128) (complex128, int, error) {
+	return besh(α, z, 2, 2)
+}
+
+// H1Seq computes the n member sequence of hankel functions of order 1 of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func H1Seq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besh1, zbesh1)
+}
+
+// H1xSeq computes the n member sequence of expontentially scaled hankel functions of order 1 of
+// orders ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func H1xSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besh1, zbesh1)
+}
+
+// H2Seq computes the n member sequence of hankel functions of order 2 of orders ν, ν+1, ..., ν+n-1
+// for complex arguments into dst and returns the number of components set to zero due
+// to underflow and the AMOS error, if any
+func H2Seq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 1, dst, besh2, zbesh2)
+}
+
+// H2xSeq computes the n member sequence of expontentially scaled hankel functions of order 2 of
+// orders ν, ν+1, ..., ν+n-1 for complex arguments into dst and returns the number of components
+// set to zero due to underflow and the AMOS error, if any
+func H2xSeq(ν float64, n int, z complex128, dst []complex128) (int, error) {
+	return sequence(ν, n, z, 2, dst, besh2, zbesh2)
+}
+
+func besh1(α float64, z complex128, kode int) (complex128, int, error) {
+	return besh(α, z, kode, 1)
+}
+
+func besh2(α float64, z complex128, kode int) (complex128, int, error) {
+	return besh(α, z, kode, 2)
+}
+
+func zbesh1(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
 	if z == 0 {
-		return cmplx.Inf()
+		return 0, fill(dst[:n], cmplx.Inf(), ErrOverflow)
 	}
-	if α < 0 {
-		factor = cmplx.Exp(complex(0, -1*math.Pi*α))
-	} else {
-		factor = complex(1, 0)
+	return zbesh(ν, z, kode, 1, n, dst)
+}
+
+func zbesh2(ν float64, z complex128, kode int, n int, dst []complex128) (int, error) {
+	if z == 0 {
+		return 0, fill(dst[:n], cmplx.Inf(), ErrOverflow)
 	}
-	_, _, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESH(real(z), imag(z), math.Abs(α), 1, 1, 1, CYR, CYI, NZ, IERR)
-	return factor * complex(CYR[1], CYI[1])
+	return zbesh(ν, z, kode, 2, n, dst)
 }
 
-// H1 computes the expontentially scaled hankel function of order 1 for complex arguments
-func H1x(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
+// zbesh computes H of kind m of orders ν, ..., ν+n-1 >= 0 into dst using a single AMOS call
+func zbesh(ν float64, z complex128, kode int, m int, n int, dst []complex128) (int, error) {
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
 	var NZ, IERR int
+	_, _, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESH(real(z), imag(z), ν, kode, m, n, CYR, CYI, NZ, IERR)
+	return NZ, unpack(CYR, CYI, IERR, dst)
+}
+
+func besh(α float64, z complex128, kode int, m int) (complex128, int, error) {
 	var factor complex128
 	if z == 0 {
-		return cmplx.Inf()
+		return cmplx.Inf(), 0, ErrOverflow
 	}
 	if α < 0 {
-		factor = cmplx.Exp(complex(0, -1*math.Pi*α))
+		// H1(-α,z) = exp(απi) H1(α,z) and H2(-α,z) = exp(-απi) H2(α,z)
+		factor = cmplx.Exp(complex(0, float64(2*m-3)*math.Pi*α))
 	} else {
 		factor = complex(1, 0)
 	}
-	_, _, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESH(real(z), imag(z), math.Abs(α), 2, 1, 1, CYR, CYI, NZ, IERR)
-	return factor * complex(CYR[1], CYI[1])
+	var cy [1]complex128
+	NZ, err := zbesh(math.Abs(α), z, kode, m, 1, cy[:])
+	return factor * cy[0], NZ, err
 }
 
-// H2 computes the hankel function of order 2 for complex arguments
-func H2(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	var factor complex128
-	if z == 0 {
-		return cmplx.Inf()
+// sequence computes the n member sequence f(ν+k, z), k=0,...,n-1, into dst. Members of
+// negative order are computed one at a time by single, which applies the reflection
+// formulas, and the remaining members of nonnegative order by one call to multi.
+func sequence(ν float64, n int, z complex128, kode int, dst []complex128,
+	single func(float64, complex128, int) (complex128, int, error),
+	multi func(float64, complex128, int, int, []complex128) (int, error)) (int, error) {
+	if n < 1 {
+		panic("n must be positive")
 	}
-	if α < 0 {
-		factor = cmplx.Exp(complex(0, math.Pi*α))
-	} else {
-		factor = complex(1, 0)
+	if len(dst) < n {
+		panic("dst is too short")
+	}
+	var nz, NZ int
+	var err, e error
+	k := 0
+	for ; k < n && ν+float64(k) < 0; k++ {
+		dst[k], NZ, e = single(ν+float64(k), z, kode)
+		nz += NZ
+		err = worst(err, e)
 	}
-	_, _, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESH(real(z), imag(z), math.Abs(α), 1, 2, 1, CYR, CYI, NZ, IERR)
-	return factor * complex(CYR[1], CYI[1])
+	if k < n {
+		NZ, e = multi(ν+float64(k), z, kode, n-k, dst[k:])
+		nz += NZ
+		err = worst(err, e)
+	}
+	return nz, err
 }
 
-// H2 computes the expontentially scalled hankel function of order 2 for complex arguments
-func H2x(α float64, z complex128) complex128 {
-	CYR := []float64{math.NaN(), 0}
-	CYI := []float64{math.NaN(), 0}
-	var NZ, IERR int
-	var factor complex128
-	if z == 0 {
-		return cmplx.Inf()
+// unpack copies the 1-indexed AMOS output arrays into dst and maps the AMOS error flag
+func unpack(CYR []float64, CYI []float64, IERR int, dst []complex128) error {
+	for j := 1; j < len(CYR); j++ {
+		dst[j-1] = complex(CYR[j], CYI[j])
 	}
-	if α < 0 {
-		factor = cmplx.Exp(complex(0, math.Pi*α))
-	} else {
-		factor = complex(1, 0)
+	return ierr(IERR)
+}
+
+// fill sets every element of dst to v and returns err
+func fill(dst []complex128, v complex128, err error) error {
+	for j := range dst {
+		dst[j] = v
 	}
-	_, _, _, _, _, _, CYR, CYI, NZ, IERR = amos.ZBESH(real(z), imag(z), math.Abs(α), 2, 2, 1, CYR, CYI, NZ, IERR)
-	return factor * complex(CYR[1], CYI[1])
+	return err
 }
@@ -0,0 +1,73 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"github.com/dreading/gospecfunc/bessel/internal/toms"
+)
+
+// J0 computes the bessel function J of order 0 for real arguments
+func J0(x float64) float64 {
+	return toms.BESJ0(x)
+}
+
+// J1 computes the bessel function J of order 1 for real arguments
+func J1(x float64) float64 {
+	return toms.BESJ1(x)
+}
+
+// Y0 computes the bessel function Y of order 0 for real arguments x >= 0
+func Y0(x float64) float64 {
+	return toms.BESY0(x)
+}
+
+// Y1 computes the bessel function Y of order 1 for real arguments x >= 0
+func Y1(x float64) float64 {
+	return toms.BESY1(x)
+}
+
+// I0 computes the bessel function I of order 0 for real arguments
+func I0(x float64) float64 {
+	return toms.BESI0(x)
+}
+
+// I0x computes the expontentially scaled bessel function I of order 0 for real arguments
+// I0x = e^(-|x|) * I0(x)
+func I0x(x float64) float64 {
+	return toms.BESI0E(x)
+}
+
+// I1 computes the bessel function I of order 1 for real arguments
+func I1(x float64) float64 {
+	return toms.BESI1(x)
+}
+
+// I1x computes the expontentially scaled bessel function I of order 1 for real arguments
+// I1x = e^(-|x|) * I1(x)
+func I1x(x float64) float64 {
+	return toms.BESI1E(x)
+}
+
+// K0 computes the bessel function K of order 0 for real arguments x >= 0
+func K0(x float64) float64 {
+	return toms.BESK0(x)
+}
+
+// K0x computes the expontentially scaled bessel function K of order 0 for real arguments x >= 0
+// K0x = e^x * K0(x)
+func K0x(x float64) float64 {
+	return toms.BESK0E(x)
+}
+
+// K1 computes the bessel function K of order 1 for real arguments x >= 0
+func K1(x float64) float64 {
+	return toms.BESK1(x)
+}
+
+// K1x computes the expontentially scaled bessel function K of order 1 for real arguments x >= 0
+// K1x = e^x * K1(x)
+func K1x(x float64) float64 {
+	return toms.BESK1E(x)
+}
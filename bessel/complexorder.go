@@ -0,0 +1,588 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The bessel functions of complex order ν are computed from two representations. For small
+// arguments the power series
+//    J(ν,z) = (z/2)^ν / Γ(ν+1) Σ (-z²/4)^k / (k! (ν+1)_k)
+// and its analogue for I with z²/4 are summed, and since sin(νπ) ≠ 0 for Im(ν) ≠ 0 the series
+// of orders ±ν also give Y, K and the hankel functions from the reflection formulas
+//    Y(ν,z) = (J(ν,z) cos(νπ) - J(-ν,z)) / sin(νπ)
+//    K(ν,z) = π/2 (I(-ν,z) - I(ν,z)) / sin(νπ)
+// Otherwise the functions follow from the integral representation
+//    K(ν,z) = ½ ∫ exp(-z cosh(t) + νt) dt
+// along the contour t = s - iθ tanh(s/2), -∞ < s < ∞, for z = r exp(iθ) with |θ| ≤ π, which
+// ends in the valleys where z cosh(t) ~ r e^|s|/2, or along the same contour shifted through
+// the saddle point t₀ = asinh(ν/z) of the integrand, whichever suffers less cancellation. The
+// integrand is analytic in a strip about the contour and decays double exponentially, so the
+// trapezoidal rule converges geometrically. The hankel functions follow from
+//    H1(ν,z) = 2/(πi) exp(-νπi/2) K(ν, z exp(-πi/2))
+//    H2(ν,z) = -2/(πi) exp(νπi/2) K(ν, z exp(πi/2))
+// continued to the rest of the plane by H1(ν, z exp(-πi)) = 2cos(νπ) H1(ν,z) + exp(-νπi) H2(ν,z)
+// and its conjugate, and then J = (H1+H2)/2 for Re(z) ≥ 0, where J(ν,-z) = exp(∓νπi) J(ν,z)
+// gives the left half plane, and I(ν,z) = exp(∓νπi/2) J(ν, z exp(±πi/2)). Where J is the
+// minimal solution of the recurrence in the order, for Re(ν) > |z|, it is found from a lower
+// order by the continued fraction for J(ν+1,z)/J(ν,z). See DLMF 10.9.E17, 10.11, 10.27 and
+// 10.32.E9. Every route estimates its own amplification of rounding errors, which grows like
+// exp(π|Im(ν)|/2) at worst, and the route with the smallest is used. For real orders the AMOS
+// functions are used.
+//
+// The function K(iτ,x) of imaginary order is real for real x > 0 and of magnitude
+// exp(-πτ/2) for x < τ, where it oscillates, so it is computed separately along the path
+// of steepest descent of exp(-x cosh(t) + iτt), which runs parallel to the real axis at
+// height π/2 up to s₁, the positive solution of τs = x sinh(s), and then descends to the
+// real axis. The integrand is oscillatory on the first part and positive on the second.
+// See A. Gil, J. Segura and N. M. Temme, Computing the modified Bessel function of purely
+// imaginary order, ACM Trans. Math. Softw. 30 (2004) 145-158.
+
+// Jc computes the bessel function J for complex orders and complex arguments
+func Jc(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return J(real(ν), z)
+	}
+	if ζ, ok := originValue(ν, z); ok {
+		return ζ
+	}
+	ζ, _ := besjc(ν, z)
+	return ζ
+}
+
+// Yc computes the bessel function Y for complex orders and complex arguments
+func Yc(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return Y(real(ν), z)
+	}
+	if z == 0 || cmplx.IsNaN(z) || cmplx.IsNaN(ν) {
+		return cmplx.NaN()
+	}
+	// Y(ν,z) = (J(ν,z) cos(νπ) - J(-ν,z)) / sin(νπ)
+	ζ, loss := reflectc(ν, z, -1, cmplx.Cos(complex(math.Pi, 0)*ν), -1)
+	if loss <= smallLoss {
+		return ζ
+	}
+	r, θ := cmplx.Polar(z)
+	h1, l1 := besh1c(ν, r, θ)
+	h2, l2 := besh2c(ν, r, θ)
+	return better(ζ, loss, (h1-h2)/2i, sumLoss(h1, l1, -h2, l2))
+}
+
+// Ic computes the bessel function I for complex orders and complex arguments
+func Ic(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return I(real(ν), z)
+	}
+	if ζ, ok := originValue(ν, z); ok {
+		return ζ
+	}
+	ζ, loss := seriesc(ν, z, 1)
+	if loss <= smallLoss {
+		return ζ
+	}
+	// I(ν,z) = exp(-νπi/2) J(ν,iz) for -π < ph(z) ≤ π/2 and exp(νπi/2) J(ν,-iz) otherwise
+	var j complex128
+	var lj float64
+	if θ := cmplx.Phase(z); θ > math.Pi/2 {
+		j, lj = besjc(ν, -1i*z)
+		j *= cmplx.Exp(ν * complex(0, math.Pi/2))
+	} else {
+		j, lj = besjc(ν, 1i*z)
+		j *= cmplx.Exp(ν * complex(0, -math.Pi/2))
+	}
+	return better(ζ, loss, j, lj+cmplx.Abs(ν))
+}
+
+// Kc computes the bessel function K for complex orders and complex arguments
+func Kc(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return K(real(ν), z)
+	}
+	if z == 0 || cmplx.IsNaN(z) || cmplx.IsNaN(ν) {
+		return cmplx.NaN()
+	}
+	// K(ν,z) = π/2 (I(-ν,z) - I(ν,z)) / sin(νπ)
+	ζ, loss := reflectc(ν, z, 1, -math.Pi/2, math.Pi/2)
+	if loss <= smallLoss {
+		return ζ
+	}
+	r, θ := cmplx.Polar(z)
+	k, lk := kContour(ν, r, θ)
+	return better(ζ, loss, k, lk)
+}
+
+// H1c computes the hankel function of order 1 for complex orders and complex arguments
+func H1c(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return H1(real(ν), z)
+	}
+	if z == 0 || cmplx.IsNaN(z) || cmplx.IsNaN(ν) {
+		return cmplx.NaN()
+	}
+	r, θ := cmplx.Polar(z)
+	ζ, _ := besh1c(ν, r, θ)
+	return ζ
+}
+
+// H2c computes the hankel function of order 2 for complex orders and complex arguments
+func H2c(ν complex128, z complex128) complex128 {
+	if imag(ν) == 0 {
+		return H2(real(ν), z)
+	}
+	if z == 0 || cmplx.IsNaN(z) || cmplx.IsNaN(ν) {
+		return cmplx.NaN()
+	}
+	r, θ := cmplx.Polar(z)
+	ζ, _ := besh2c(ν, r, θ)
+	return ζ
+}
+
+// Kit computes the bessel function K(iτ,x) of imaginary order for real τ and x > 0
+func Kit(τ float64, x float64) float64 {
+	return kit(math.Abs(τ), x, false)
+}
+
+// Kitx computes the exponentially scaled bessel function K of imaginary order for real τ and x > 0
+// Kitx = exp(π|τ|/2) * K(iτ,x)
+func Kitx(τ float64, x float64) float64 {
+	return kit(math.Abs(τ), x, true)
+}
+
+// originValue returns J(ν,0) or I(ν,0) and true, or false for z ≠ 0. The limit is zero for
+// Re(ν) > 0 and does not exist otherwise, since |(z/2)^ν| = |z/2|^Re(ν) exp(-Im(ν) ph(z)).
+func originValue(ν complex128, z complex128) (complex128, bool) {
+	switch {
+	case cmplx.IsNaN(z) || cmplx.IsNaN(ν):
+		return cmplx.NaN(), true
+	case z != 0:
+		return 0, false
+	case real(ν) > 0:
+		return 0, true
+	}
+	return cmplx.NaN(), true
+}
+
+// Each method below also returns an estimate of the factor by which it amplifies rounding
+// errors, and where there is a choice the functions are computed by the method with the
+// smaller factor. The power series are always tried first, being the cheaper, and accepted
+// outright if the factor is at most smallLoss.
+const smallLoss = 16
+
+// better returns whichever of a and b has the smaller error amplification
+func better(a complex128, la float64, b complex128, lb float64) complex128 {
+	if la <= lb {
+		return a
+	}
+	return b
+}
+
+// sumLoss returns the error amplification of a+b, given those of a and b
+func sumLoss(a complex128, la float64, b complex128, lb float64) float64 {
+	return math.Max(la*cmplx.Abs(a), lb*cmplx.Abs(b)) / cmplx.Abs(a+b)
+}
+
+// besjc computes J(ν,z) for z ≠ 0 from the power series or J = (H1+H2)/2
+func besjc(ν complex128, z complex128) (complex128, float64) {
+	ζ, loss := seriesc(ν, z, -1)
+	if loss <= smallLoss {
+		return ζ, loss
+	}
+	if real(z) < 0 {
+		// J(ν,z) = exp(±νπi) J(ν,-z) for Im(z) ≥ 0 and Im(z) < 0, which avoids the analytic
+		// continuation of the hankel functions to the left half plane
+		s := complex(0, math.Pi)
+		if imag(z) < 0 {
+			s = -s
+		}
+		j, lj := besjc(ν, -z)
+		return cmplx.Exp(s*ν) * j, lj + cmplx.Abs(ν)
+	}
+	r, θ := cmplx.Polar(z)
+	h1, l1 := besh1c(ν, r, θ)
+	h2, l2 := besh2c(ν, r, θ)
+	j, lj := (h1+h2)/2, sumLoss(h1, l1, h2, l2)
+	if lj < loss {
+		ζ, loss = j, lj
+	}
+	// J is the minimal solution of the recurrence in the order, so where Re(ν) > |z| it may be
+	// much smaller than both the terms of the series and the hankel functions. It is then found
+	// from the order μ = ν-n with Re(μ) below the transition region about |z|, of width of order
+	// |z|^(1/3), and the ratios J(μ+k+1,z)/J(μ+k,z).
+	a := cmplx.Abs(z)
+	if n := math.Floor(real(ν) - math.Max(0, a-3*math.Cbrt(a))); loss > smallLoss && n >= 1 {
+		μ := ν - complex(n, 0)
+		m, lm := besjc(μ, z)
+		r := make([]complex128, int(n))
+		ratioc(μ, z, r)
+		for _, rk := range r {
+			m *= rk
+		}
+		if lm += n; lm < loss {
+			ζ, loss = m, lm
+		}
+	}
+	return ζ, loss
+}
+
+// ratioc computes J(μ+k+1,z)/J(μ+k,z), k = 0, ..., len(dst)-1, by the backward recurrence
+//    J(μ+k+1,z)/J(μ+k,z) = z / (2(μ+k+1) - z J(μ+k+2,z)/J(μ+k+1,z))
+// which evaluates the continued fraction and is stable for the minimal solution J. The
+// recurrence is started from zero at an order beyond which the ratios are below machine
+// precision.
+func ratioc(μ complex128, z complex128, dst []complex128) {
+	n := len(dst)
+	N := n + int(cmplx.Abs(z)) + 20
+	// the ratios are roughly |z| / (2|μ+k|) for large k
+	for ; N < 100000; N += 10 {
+		if cmplx.Abs(z)/(2*cmplx.Abs(μ+complex(float64(N), 0))) < 0.5 {
+			break
+		}
+	}
+	N += 60
+	r := complex(0, 0)
+	for k := N; k >= 0; k-- {
+		r = z / (2*(μ+complex(float64(k+1), 0)) - z*r)
+		if k < n {
+			dst[k] = r
+		}
+	}
+}
+
+// besh1c computes H1(ν,z) for z = r exp(iθ), |θ| ≤ π
+func besh1c(ν complex128, r float64, θ float64) (complex128, float64) {
+	// H1(ν,z) = (J(-ν,z) - exp(-νπi) J(ν,z)) / (i sin(νπ))
+	ζ, loss := reflectc(ν, cmplx.Rect(r, θ), -1, 1i*cmplx.Exp(complex(0, -math.Pi)*ν), -1i)
+	if loss <= smallLoss {
+		return ζ, loss
+	}
+	var h complex128
+	var lh float64
+	if θ >= -math.Pi/2 {
+		k, lk := kContour(ν, r, θ-math.Pi/2)
+		h, lh = complex(0, -2/math.Pi)*cmplx.Exp(ν*complex(0, -math.Pi/2))*k, lk+cmplx.Abs(ν)
+	} else {
+		// H1(ν,w exp(-πi)) = 2 cos(νπ) H1(ν,w) + exp(-νπi) H2(ν,w) with 0 < ph(w) < π/2
+		h1, l1 := besh1c(ν, r, θ+math.Pi)
+		h2, l2 := besh2c(ν, r, θ+math.Pi)
+		a, b := 2*cmplx.Cos(complex(math.Pi, 0)*ν)*h1, cmplx.Exp(complex(0, -math.Pi)*ν)*h2
+		h, lh = a+b, sumLoss(a, l1+cmplx.Abs(ν), b, l2+cmplx.Abs(ν))
+	}
+	if loss <= lh {
+		return ζ, loss
+	}
+	return h, lh
+}
+
+// besh2c computes H2(ν,z) for z = r exp(iθ), |θ| ≤ π
+func besh2c(ν complex128, r float64, θ float64) (complex128, float64) {
+	// H2(ν,z) = (J(-ν,z) - exp(νπi) J(ν,z)) / (-i sin(νπ))
+	ζ, loss := reflectc(ν, cmplx.Rect(r, θ), -1, -1i*cmplx.Exp(complex(0, math.Pi)*ν), 1i)
+	if loss <= smallLoss {
+		return ζ, loss
+	}
+	var h complex128
+	var lh float64
+	if θ <= math.Pi/2 {
+		k, lk := kContour(ν, r, θ+math.Pi/2)
+		h, lh = complex(0, 2/math.Pi)*cmplx.Exp(ν*complex(0, math.Pi/2))*k, lk+cmplx.Abs(ν)
+	} else {
+		// H2(ν,w exp(πi)) = 2 cos(νπ) H2(ν,w) + exp(νπi) H1(ν,w) with -π/2 < ph(w) < 0
+		h1, l1 := besh1c(ν, r, θ-math.Pi)
+		h2, l2 := besh2c(ν, r, θ-math.Pi)
+		a, b := 2*cmplx.Cos(complex(math.Pi, 0)*ν)*h2, cmplx.Exp(complex(0, math.Pi)*ν)*h1
+		h, lh = a+b, sumLoss(a, l2+cmplx.Abs(ν), b, l1+cmplx.Abs(ν))
+	}
+	if loss <= lh {
+		return ζ, loss
+	}
+	return h, lh
+}
+
+// seriesc sums the power series of J (σ = -1) or I (σ = 1). Rounding errors are amplified by
+// the ratio of the sum of the magnitudes of the terms to the magnitude of the sum and, in the
+// factor (z/2)^ν / Γ(ν+1), by the magnitude of its exponent.
+func seriesc(ν complex128, z complex128, σ float64) (complex128, float64) {
+	q := complex(σ/4, 0) * z * z
+	s, t := complex(1, 0), complex(1, 0)
+	a := 1.0
+	for k := 1; k < 500; k++ {
+		νk := ν + complex(float64(k), 0)
+		t *= q / (complex(float64(k), 0) * νk)
+		s += t
+		at := cmplx.Abs(t)
+		a += at
+		// the terms decrease monotonically once |q| < k |ν+k|/2
+		if at <= machineEpsilon*cmplx.Abs(s)/4 && 2*cmplx.Abs(q) < float64(k)*cmplx.Abs(νk) {
+			e1, e2 := ν*cmplx.Log(z/2), lgammac(ν+1)
+			return cmplx.Exp(e1-e2) * s, a/cmplx.Abs(s) + cmplx.Abs(e1) + cmplx.Abs(e2)
+		}
+	}
+	return cmplx.NaN(), math.Inf(1)
+}
+
+// reflectc computes (p J(ν,z) + q J(-ν,z)) / sin(νπ) for σ = -1, or the same with I for σ = 1,
+// from the power series. For complex orders sin(νπ) does not vanish.
+func reflectc(ν complex128, z complex128, σ float64, p complex128, q complex128) (complex128, float64) {
+	a, la := seriesc(ν, z, σ)
+	b, lb := seriesc(-ν, z, σ)
+	if math.IsInf(la, 1) || math.IsInf(lb, 1) {
+		return cmplx.NaN(), math.Inf(1)
+	}
+	u, v := p*a, q*b
+	return (u + v) / cmplx.Sin(complex(math.Pi, 0)*ν), sumLoss(u, la, v, lb) + cmplx.Abs(ν)
+}
+
+// kContour computes K(ν,z) for z = r exp(iθ), r > 0 and |θ| ≤ π. The integrand is entire, so
+// any contour t = s + iψ(s) with ψ → θ as s → -∞ and ψ → -θ as s → ∞ gives K, and the one
+// with the least cancellation is used of
+//    ψ(s) = -θ tanh(s/2)
+//    ψ(s) = -θ tanh(u/2) + Im(t₀) sech(u/2) and -θ tanh(u/2) + Im(t₀) sech(u), u = s - Re(t₀)
+// where the last two pass through the saddle point t₀ = asinh(ν/z) of the integrand, which is
+// far from the origin for |ν| comparable to or larger than |z|.
+func kContour(ν complex128, r float64, θ float64) (complex128, float64) {
+	ζ, loss := kPath(ν, r, θ, 0, 0, 0)
+	if loss > 2 {
+		t0 := cmplx.Asinh(ν / cmplx.Rect(r, θ))
+		for _, q := range []float64{0.5, 1} {
+			if k, lk := kPath(ν, r, θ, real(t0), imag(t0), q); lk < loss {
+				ζ, loss = k, lk
+			}
+		}
+	}
+	// the factor exp(-z) contributes a relative error of order r
+	return ζ, loss + r
+}
+
+// kPath computes K(ν,z) for z = r exp(iθ) by the trapezoidal rule along the contour
+// ψ(s) = -θ tanh(u/2) + c sech(qu), u = s - s₀, with the integrand written as
+// exp(-z) exp(-2z sinh²(t/2) + νt). The step h is chosen so that the discretisation error, which
+// is of order exp(-2πd/h) times the integrand on lines at a distance d from the contour, is below
+// the rounding error. The poles of tanh(u/2) and sech(qu) limit d to less than π/2. Rounding
+// errors are amplified by the ratio of the sum of the magnitudes of the terms to the magnitude
+// of the sum.
+func kPath(ν complex128, r float64, θ float64, s0 float64, c float64, q float64) (complex128, float64) {
+	z := cmplx.Rect(r, θ)
+	h := 2.5 / (40 + r + math.Pi*cmplx.Abs(ν)/2)
+	f := func(u float64) complex128 {
+		ch, sech := math.Cosh(u/2), 1/math.Cosh(q*u)
+		t := complex(s0+u, -θ*math.Tanh(u/2)+c*sech)
+		w := cmplx.Sinh(t / 2)
+		return cmplx.Exp(-2*z*w*w+ν*t) * complex(1, -θ/(2*ch*ch)-q*c*sech*math.Tanh(q*u))
+	}
+	sum := f(0)
+	a := cmplx.Abs(sum)
+	for k := 1; k < 1000000; k++ {
+		u := float64(k) * h
+		fp, fm := f(u), f(-u)
+		sum += fp + fm
+		at := cmplx.Abs(fp) + cmplx.Abs(fm)
+		a += at
+		if at <= machineEpsilon*cmplx.Abs(sum)/16 && u > 3 {
+			break
+		}
+	}
+	return complex(h/2, 0) * sum * cmplx.Exp(-z), a / cmplx.Abs(sum)
+}
+
+// Stirling's series log Γ(z) ~ (z-½) log(z) - z + log(2π)/2 + Σ B(2k) / (2k(2k-1) z^(2k-1))
+var stirling = []float64{
+	1.0 / 12,
+	-1.0 / 360,
+	1.0 / 1260,
+	-1.0 / 1680,
+	1.0 / 1188,
+	-691.0 / 360360,
+	1.0 / 156,
+	-3617.0 / 122400,
+}
+
+// lgammac computes log Γ(z) for complex z, up to a multiple of 2πi, from Stirling's series
+// for |z| ≥ 15 after the recurrence Γ(z+1) = z Γ(z) and the reflection Γ(z) Γ(1-z) = π / sin(πz)
+func lgammac(z complex128) complex128 {
+	if real(z) < 0.5 {
+		return complex(math.Log(math.Pi), 0) - logSinπ(z) - lgammac(1-z)
+	}
+	p := complex(1, 0)
+	for cmplx.Abs(z) < 15 {
+		p *= z
+		z++
+	}
+	w := 1 / (z * z)
+	s := complex(0, 0)
+	for k := len(stirling) - 1; k >= 0; k-- {
+		s = s*w + complex(stirling[k], 0)
+	}
+	return (z-0.5)*cmplx.Log(z) - z + complex(0.5*math.Log(2*math.Pi), 0) + s/z - cmplx.Log(p)
+}
+
+// logSinπ computes log sin(πz), up to a multiple of 2πi, without overflow for large |Im(z)|
+func logSinπ(z complex128) complex128 {
+	x, y := real(z), imag(z)
+	if math.Abs(y) < 20 {
+		s, c := sincospi(x)
+		return cmplx.Log(complex(s*math.Cosh(math.Pi*y), c*math.Sinh(math.Pi*y)))
+	}
+	if y < 0 {
+		return cmplx.Conj(logSinπ(cmplx.Conj(z)))
+	}
+	// sin(πz) = (i/2) exp(-πiz) (1 - exp(2πiz)) for Im(z) > 0
+	s, c := sincospi(2 * x)
+	e := math.Exp(-2 * math.Pi * y)
+	return complex(-math.Ln2, math.Pi/2) - complex(0, math.Pi)*z + cmplx.Log(complex(1-e*c, -e*s))
+}
+
+// kit computes K(iτ,x) for τ ≥ 0, scaled by exp(πτ/2) if scaled is true, as
+//    e^(-πτ/2) ∫ cos(τs - x sinh(s)) ds over [0, s₁] + ∫ exp(-x cosh(s) cos(ψ) - τψ) ds over [s₁, ∞)
+// with sin(ψ) = τs / (x sinh(s)) on the descending part of the path. The first integral is
+// found by Gauss-Legendre quadrature on panels short enough to resolve the oscillations and
+// the second by tanh-sinh quadrature, which is unaffected by the square root singularity of
+// ψ at s₁.
+func kit(τ float64, x float64, scaled bool) float64 {
+	switch {
+	case x <= 0 || math.IsNaN(x) || math.IsNaN(τ):
+		return math.NaN()
+	case math.IsInf(x, 1) || math.IsInf(τ, 1):
+		// the scaled function also vanishes as τ → ∞, like √(2π/τ)
+		return 0
+	}
+
+	// s₁ solves f(s) = x sinh(s) - τs = 0, which is convex, so Newton's method converges
+	// monotonically from the right
+	s1 := 0.0
+	if τ > x {
+		s := math.Log(2*τ/x) + 1
+		for x*math.Sinh(s) <= τ*s {
+			s *= 2
+		}
+		for i := 0; i < 100; i++ {
+			δ := (x*math.Sinh(s) - τ*s) / (x*math.Cosh(s) - τ)
+			s -= δ
+			if δ <= 1e-15*s {
+				break
+			}
+		}
+		s1 = s
+	}
+
+	// the exponent on the descending part, less -πτ/2, with π/2 - ψ = acos(σ)
+	shift := τ * math.Pi / 2
+	if !scaled {
+		shift = 0
+	}
+	exponent := func(s float64) float64 {
+		var σ float64
+		if s == 0 {
+			σ = τ / x
+		} else {
+			σ = τ * s / (x * math.Sinh(s))
+		}
+		if σ >= 1 {
+			return shift - τ*math.Pi/2
+		}
+		return -x*math.Cosh(s)*math.Sqrt((1-σ)*(1+σ)) + τ*math.Acos(σ) - τ*math.Pi/2 + shift
+	}
+
+	var sum float64
+	if s1 > 0 {
+		// the phase τs - x sinh(s) has derivative τ - x cosh(s), which is largest in magnitude
+		// at one of the end points
+		d := math.Max(math.Abs(τ-x), math.Abs(x*math.Cosh(s1)-τ))
+		n := int(math.Ceil(s1 / math.Min(0.5, 2/d)))
+		w := s1 / float64(n)
+		for j := 0; j < n; j++ {
+			a := float64(j) * w
+			for i, ξ := range legendre20.x {
+				s := a + w*(1+ξ)/2
+				sum += w / 2 * legendre20.w[i] * math.Cos(τ*s-x*math.Sinh(s))
+			}
+		}
+		if !scaled {
+			sum *= math.Exp(-τ * math.Pi / 2)
+		}
+	}
+
+	// the tail is truncated where the integrand is below exp(-40) times its value at s₁
+	e0 := exponent(s1)
+	b := 1.0
+	for exponent(s1+b) > e0-40 {
+		b *= 2
+	}
+	sum += tanhSinh(func(s float64) float64 { return math.Exp(exponent(s)) }, s1, s1+b)
+	return sum
+}
+
+// tanhSinh integrates f over [a, b] with the substitution s = (a+b)/2 + (b-a)/2 tanh(π/2 sinh(u))
+// and the trapezoidal rule in u, halving the step until successive estimates agree
+func tanhSinh(f func(float64) float64, a float64, b float64) float64 {
+	c, d := (a+b)/2, (b-a)/2
+	term := func(u float64) (float64, float64) {
+		sh, ch := math.Sinh(u), math.Cosh(u)
+		q := math.Exp(-math.Pi * sh)
+		// 1 - tanh(π/2 sinh(u)) = 2q/(1+q) is computed directly for accuracy near b
+		y := 2 * q / (1 + q)
+		w := math.Pi * ch * y * (2 - y) / 2
+		return d * w * (f(c+d*(1-y)) + f(c-d*(1-y))), y
+	}
+	h := 0.5
+	sum := d * math.Pi / 2 * f(c)
+	for u := h; ; u += h {
+		t, y := term(u)
+		sum += t
+		if d*y < 1e-300 || y < 1e-17 && math.Abs(t) < 1e-20*math.Abs(sum) {
+			break
+		}
+	}
+	prev := sum * h
+	for level := 0; level < 8; level++ {
+		h /= 2
+		for u := h; ; u += 2 * h {
+			t, y := term(u)
+			sum += t
+			if d*y < 1e-300 || y < 1e-17 && math.Abs(t) < 1e-20*math.Abs(sum) {
+				break
+			}
+		}
+		est := sum * h
+		if math.Abs(est-prev) <= 1e-15*math.Abs(est) && level > 1 {
+			return est
+		}
+		prev = est
+	}
+	return prev
+}
+
+// legendre20 holds the nodes and weights of the 20 point Gauss-Legendre rule on [-1, 1]
+var legendre20 = gaussLegendre(20)
+
+// gaussLegendre computes the nodes and weights of the n point Gauss-Legendre rule by Newton's
+// method on the Legendre polynomial P(n,x), starting from the Chebyshev approximations
+func gaussLegendre(n int) struct{ x, w []float64 } {
+	x := make([]float64, n)
+	w := make([]float64, n)
+	for i := 0; i < (n+1)/2; i++ {
+		ξ := math.Cos(math.Pi * (float64(i) + 0.75) / (float64(n) + 0.5))
+		var dp float64
+		for it := 0; it < 100; it++ {
+			// P(k+1,ξ) = ((2k+1) ξ P(k,ξ) - k P(k-1,ξ)) / (k+1)
+			p0, p1 := 1.0, ξ
+			for k := 1; k < n; k++ {
+				p0, p1 = p1, (float64(2*k+1)*ξ*p1-float64(k)*p0)/float64(k+1)
+			}
+			dp = float64(n) * (ξ*p1 - p0) / (ξ*ξ - 1)
+			δ := p1 / dp
+			ξ -= δ
+			if math.Abs(δ) < 1e-16 {
+				break
+			}
+		}
+		x[i], x[n-1-i] = -ξ, ξ
+		w[i] = 2 / ((1 - ξ*ξ) * dp * dp)
+		w[n-1-i] = w[i]
+	}
+	return struct{ x, w []float64 }{x, w}
+}
@@ -0,0 +1,356 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The cross products of the bessel functions J and Y for real arguments 0 < a, b are
+//    JY(ν,a,b)   = J(ν,a) Y(ν,b) - J(ν,b) Y(ν,a)
+//    JYd(ν,a,b)  = J(ν,a) Y'(ν,b) - J'(ν,b) Y(ν,a)
+//    JdY(ν,a,b)  = J'(ν,a) Y(ν,b) - J(ν,b) Y'(ν,a)
+//    JdYd(ν,a,b) = J'(ν,a) Y'(ν,b) - J'(ν,b) Y'(ν,a)
+// and are invariant under ν → -ν. As functions of b they are the solutions u and v of
+// Bessel's equation, and their derivatives, with u(a) = 0, u'(a) = 2/(πa), v(a) = -2/(πa) and
+// v'(a) = 0, since the Wronskian is J(ν,a) Y'(ν,a) - J'(ν,a) Y(ν,a) = 2/(πa). Where b is close
+// to a, measured against the local scale min(1, a/ν) on which the functions vary, the products
+// cancel and the cross products are summed from the Taylor series of u and v about a. Elsewhere
+// they are formed from the Frexp variants of the functions, so the products remain finite where
+// J underflows and Y overflows. See DLMF 10.21(xi).
+//
+// With J = M cos(θ), Y = M sin(θ), J' = N cos(φ) and Y' = N sin(φ) the cross products are
+// products of the moduli and the sine of a phase difference such as JY(ν,a,b) = M(a) M(b)
+// sin(θ(b) - θ(a)), whose cosine is the corresponding dot product J(ν,a) J(ν,b) + Y(ν,a) Y(ν,b).
+// The zeros x of the cross products at a = x and b = λx are found by following this phase
+// difference continuously from the smallest possible zero, x = ν/λ, below which the solutions of
+// Bessel's equation do not oscillate on [x, λx], in steps in which it changes by less than π/4,
+// and by regula falsi on it between the steps at which it crosses a multiple of π.
+
+// The kinds of cross product
+const (
+	crossJY = iota
+	crossJYd
+	crossJdY
+	crossJdYd
+)
+
+// CrossJY computes the cross product J(ν,a) Y(ν,b) - J(ν,b) Y(ν,a) of the bessel functions for a, b > 0
+func CrossJY(ν float64, a float64, b float64) float64 {
+	return besselCross(ν, a, b)[crossJY]
+}
+
+// CrossJYd computes the cross product J(ν,a) Y'(ν,b) - J'(ν,b) Y(ν,a) of the bessel functions for a, b > 0
+func CrossJYd(ν float64, a float64, b float64) float64 {
+	return besselCross(ν, a, b)[crossJYd]
+}
+
+// CrossJdY computes the cross product J'(ν,a) Y(ν,b) - J(ν,b) Y'(ν,a) of the bessel functions for a, b > 0
+func CrossJdY(ν float64, a float64, b float64) float64 {
+	return besselCross(ν, a, b)[crossJdY]
+}
+
+// CrossJdYd computes the cross product J'(ν,a) Y'(ν,b) - J'(ν,b) Y'(ν,a) of the bessel functions for a, b > 0
+func CrossJdYd(ν float64, a float64, b float64) float64 {
+	return besselCross(ν, a, b)[crossJdYd]
+}
+
+// CrossJYZero computes the k-th positive zero x of the cross product CrossJY(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJYZero(ν float64, λ float64, k int) float64 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+	return crossZeros(ν, λ, k, crossJY)[k-1]
+}
+
+// CrossJYZeros computes the first n positive zeros x of the cross product CrossJY(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJYZeros(ν float64, λ float64, n int) []float64 {
+	return crossZeros(ν, λ, n, crossJY)
+}
+
+// CrossJYdZero computes the k-th positive zero x of the cross product CrossJYd(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJYdZero(ν float64, λ float64, k int) float64 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+	return crossZeros(ν, λ, k, crossJYd)[k-1]
+}
+
+// CrossJYdZeros computes the first n positive zeros x of the cross product CrossJYd(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJYdZeros(ν float64, λ float64, n int) []float64 {
+	return crossZeros(ν, λ, n, crossJYd)
+}
+
+// CrossJdYZero computes the k-th positive zero x of the cross product CrossJdY(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJdYZero(ν float64, λ float64, k int) float64 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+	return crossZeros(ν, λ, k, crossJdY)[k-1]
+}
+
+// CrossJdYZeros computes the first n positive zeros x of the cross product CrossJdY(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJdYZeros(ν float64, λ float64, n int) []float64 {
+	return crossZeros(ν, λ, n, crossJdY)
+}
+
+// CrossJdYdZero computes the k-th positive zero x of the cross product CrossJdYd(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJdYdZero(ν float64, λ float64, k int) float64 {
+	if k < 1 {
+		panic("k must be positive")
+	}
+	return crossZeros(ν, λ, k, crossJdYd)[k-1]
+}
+
+// CrossJdYdZeros computes the first n positive zeros x of the cross product CrossJdYd(ν,x,λx) for ν ≥ 0 and λ > 1
+func CrossJdYdZeros(ν float64, λ float64, n int) []float64 {
+	return crossZeros(ν, λ, n, crossJdYd)
+}
+
+// besselCross computes the four cross products JY, JYd, JdY and JdYd
+func besselCross(ν float64, a float64, b float64) [4]float64 {
+	ν = math.Abs(ν)
+	switch {
+	case math.IsNaN(ν) || math.IsNaN(a) || math.IsNaN(b) || a <= 0 || b <= 0:
+		return [4]float64{math.NaN(), math.NaN(), math.NaN(), math.NaN()}
+	case math.IsInf(ν, 1):
+		return [4]float64{math.NaN(), math.NaN(), math.NaN(), math.NaN()}
+	case math.IsInf(a, 1) || math.IsInf(b, 1):
+		// the functions and their derivatives all vanish at infinity
+		return [4]float64{}
+	case crossNear(ν, a, b):
+		return crossTaylor(ν, a, b-a)
+	}
+	var c [4]float64
+	m, e := crossFrexp(ν, a, b)
+	for kind := range c {
+		c[kind] = math.Ldexp(real(m[kind]), e[kind])
+	}
+	return c
+}
+
+// crossNear reports whether b is close enough to a for the Taylor series of the cross products
+func crossNear(ν float64, a float64, b float64) bool {
+	h := math.Abs(b - a)
+	return h <= a/2 && h*math.Max(1, ν/a) <= 0.5
+}
+
+// crossFrexp computes the four cross products as mantissas and exponents
+func crossFrexp(ν float64, a float64, b float64) ([4]complex128, [4]int) {
+	fa, ea := besselJYFrexp(ν, a)
+	fb, eb := besselJYFrexp(ν, b)
+	return crossCombine(fa, ea, fb, eb, false)
+}
+
+// crossDotFrexp computes the four cross products and the corresponding dot products, such as
+// J(ν,a) J(ν,b) + Y(ν,a) Y(ν,b) for JY, as mantissas and exponents
+func crossDotFrexp(ν float64, a float64, b float64) ([4]complex128, [4]int, [4]complex128, [4]int) {
+	fa, ea := besselJYFrexp(ν, a)
+	fb, eb := besselJYFrexp(ν, b)
+	m, e := crossCombine(fa, ea, fb, eb, false)
+	dm, de := crossCombine(fa, ea, fb, eb, true)
+	return m, e, dm, de
+}
+
+// crossCombine forms the cross products P(a) Q(b) - R(b) S(a) from J, J', Y and Y' at a and b,
+// where P and R are J or J' and Q and S are Y or Y', or with dot set the dot products
+// P(a) R(b) + S(a) Q(b)
+func crossCombine(fa [4]complex128, ea [4]int, fb [4]complex128, eb [4]int, dot bool) ([4]complex128, [4]int) {
+	// the indices of P, Q, R and S into J, J', Y, Y'
+	var terms = [4][4]int{
+		crossJY:   {0, 2, 0, 2},
+		crossJYd:  {0, 3, 1, 2},
+		crossJdY:  {1, 2, 0, 3},
+		crossJdYd: {1, 3, 1, 3},
+	}
+	var m [4]complex128
+	var e [4]int
+	for kind, t := range terms {
+		p, q, r, s := t[0], t[1], t[2], t[3]
+		σ := complex(-1, 0)
+		if dot {
+			q, r, σ = r, q, 1
+		}
+		x, xe := normalize(fa[p]*fb[q], ea[p]+eb[q])
+		y, ye := normalize(fb[r]*fa[s], eb[r]+ea[s])
+		m[kind], e[kind] = frexpAdd(x, xe, σ*y, ye)
+	}
+	return m, e
+}
+
+// besselJYFrexp computes J(ν,x), J'(ν,x), Y(ν,x) and Y'(ν,x) as mantissas and exponents, with
+// the derivatives from C'(ν,x) = (ν/x) C(ν,x) - C(ν+1,x). The orders ν and ν+1 come from a
+// single call to AMOS where neither is near overflow or underflow.
+func besselJYFrexp(ν float64, x float64) ([4]complex128, [4]int) {
+	var m [4]complex128
+	var e [4]int
+	z := complex(x, 0)
+	for i, kind := range []int{frexpJ, frexpY} {
+		multi := zbesj
+		if kind == frexpY {
+			multi = zbesy
+		}
+		var cy [2]complex128
+		var c, c1 complex128
+		var ce, c1e int
+		NZ, err := multi(ν, z, 1, 2, cy[:])
+		// results near the limits of the float64 range, which may be denormal, are recomputed
+		normal := func(c complex128) bool { return cmplx.Abs(c) > 1e-290 && cmplx.Abs(c) < 1e290 }
+		if (err == nil || err == ErrPrecisionLoss) && NZ == 0 && normal(cy[0]) && normal(cy[1]) {
+			c, ce = normalize(cy[0], 0)
+			c1, c1e = normalize(cy[1], 0)
+		} else {
+			c, ce = besselFrexp(ν, z, kind)
+			c1, c1e = besselFrexp(ν+1, z, kind)
+		}
+		m[2*i], e[2*i] = c, ce
+		c, ce = normalize(complex(ν/x, 0)*c, ce)
+		m[2*i+1], e[2*i+1] = frexpAdd(c, ce, -c1, c1e)
+	}
+	return m, e
+}
+
+// crossTaylor computes the four cross products at b = a + h from the Taylor series about a of
+// the solutions u and v of Bessel's equation, whose coefficients satisfy
+//    a² (n+1)(n+2) c(n+2) = -a (n+1)(2n+1) c(n+1) - (n² + a² - ν²) c(n) - 2a c(n-1) - c(n-2)
+func crossTaylor(ν float64, a float64, h float64) [4]float64 {
+	w := 2 / (math.Pi * a)
+	u, ud := besselTaylor(ν, a, h, 0, w)
+	v, vd := besselTaylor(ν, a, h, -w, 0)
+	return [4]float64{u, ud, v, vd}
+}
+
+// besselTaylor sums the Taylor series in h of the solution of Bessel's equation with the value
+// c0 and derivative c1 at a, and of its derivative
+func besselTaylor(ν float64, a float64, h float64, c0 float64, c1 float64) (float64, float64) {
+	// c(n-2), c(n-1), c(n) and c(n+1)
+	cm2, cm1, cn, cn1 := 0.0, 0.0, c0, c1
+	s, sd := c0+c1*h, c1
+	p := h // h^(n+1)
+	small := 0
+	for n := 0; n < 500 && small < 3; n++ {
+		fn := float64(n)
+		cn2 := -(a*(fn+1)*(2*fn+1)*cn1 + (fn*fn+(a-ν)*(a+ν))*cn + 2*a*cm1 + cm2) / (a * a * (fn + 1) * (fn + 2))
+		t, td := cn2*p*h, (fn+2)*cn2*p
+		s += t
+		sd += td
+		if math.Abs(t) <= machineEpsilon*math.Abs(s)/4 && math.Abs(td) <= machineEpsilon*math.Abs(sd)/4 {
+			small++
+		} else {
+			small = 0
+		}
+		cm2, cm1, cn, cn1 = cm1, cn, cn1, cn2
+		p *= h
+	}
+	return s, sd
+}
+
+// crossPhase computes the phase difference of the given kind of cross product at a = x and
+// b = λx in (-π, π] as the argument of the dot product + i the cross product
+func crossPhase(ν float64, λ float64, x float64, kind int) float64 {
+	m, e, dm, de := crossDotFrexp(ν, x, λ*x)
+	c, d := real(m[kind]), real(dm[kind])
+	if crossNear(ν, x, λ*x) {
+		// the cross product cancels in its frexp form, and the dot product does not
+		c, e[kind] = math.Frexp(crossTaylor(ν, x, (λ-1)*x)[kind])
+	}
+	if e[kind] < de[kind] {
+		return math.Atan2(math.Ldexp(c, e[kind]-de[kind]), d)
+	}
+	return math.Atan2(c, math.Ldexp(d, de[kind]-e[kind]))
+}
+
+func crossZeros(ν float64, λ float64, n int, kind int) []float64 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	if λ <= 1 || math.IsNaN(λ) || math.IsInf(λ, 1) {
+		panic("ratio must be greater than 1")
+	}
+	if n < 0 {
+		panic("n must be non-negative")
+	}
+
+	zeros := make([]float64, 0, n)
+	// the phase difference ψ is followed continuously from x = ν/λ, or from a point below the
+	// first zero, which is close to 2.4/λ for ν = 0 and large λ
+	x := math.Max(ν/λ, 0.01/λ)
+	ψ := crossPhase(ν, λ, x, kind)
+	h := x
+	for len(zeros) < n {
+		// a step in which ψ changes by a multiple of 2π would pass unnoticed, so it is also
+		// bounded by the rate of change of ψ estimated from that of the phases, which is about
+		// √(1 - ν²/x²) for x > ν and smaller otherwise, taken at the ends of the step which
+		// make the estimate an upper bound
+		for h*(λ*crossRate(ν, λ*(x+h))-crossRate(ν, x)) > math.Pi/8 {
+			h /= 2
+		}
+		x1 := x + h
+		d := crossPhase(ν, λ, x1, kind) - ψ
+		d -= 2 * math.Pi * math.Round(d/(2*math.Pi))
+		if math.Abs(d) > math.Pi/4 {
+			h /= 2
+			continue
+		}
+		ψ1 := ψ + d
+		if k0, k1 := math.Floor(ψ/math.Pi), math.Floor(ψ1/math.Pi); k0 != k1 {
+			m := math.Max(k0, k1) * math.Pi
+			zeros = append(zeros, crossRoot(ν, λ, kind, x, x1, ψ-m, ψ1-m))
+		}
+		x, ψ = x1, ψ1
+		if math.Abs(d) < math.Pi/16 {
+			h *= 2
+		}
+	}
+	return zeros
+}
+
+// crossRate estimates the rate of change √(1 - ν²/x²) of the phases of the bessel functions
+// and their derivatives, which vanishes for x ≤ ν
+func crossRate(ν float64, x float64) float64 {
+	if x <= ν {
+		return 0
+	}
+	return math.Sqrt((1 - ν/x) * (1 + ν/x))
+}
+
+// crossRoot finds the zero of the cross product between x0 and x1, at which the phase
+// difference ψ, measured from the multiple of π it crosses, changes sign from g0 to g1, by the
+// Illinois variant of regula falsi
+func crossRoot(ν float64, λ float64, kind int, x0 float64, x1 float64, g0 float64, g1 float64) float64 {
+	if g0 == 0 {
+		return x0
+	}
+	side := 0
+	for i := 0; i < 100; i++ {
+		x := (x0*g1 - x1*g0) / (g1 - g0)
+		if x1-x0 <= 4*machineEpsilon*x1 || x <= x0 || x >= x1 {
+			return x
+		}
+		g := crossPhase(ν, λ, x, kind)
+		// the phase difference lies within π/4 of the multiple of π
+		if math.Abs(g) > math.Pi/2 {
+			g -= math.Copysign(math.Pi, g)
+		}
+		switch {
+		case g == 0:
+			return x
+		case (g < 0) == (g0 < 0):
+			x0, g0 = x, g
+			if side == -1 {
+				g1 /= 2
+			}
+			side = -1
+		default:
+			x1, g1 = x, g
+			if side == 1 {
+				g0 /= 2
+			}
+			side = 1
+		}
+	}
+	return (x0 + x1) / 2
+}
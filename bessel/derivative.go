@@ -0,0 +1,222 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The derivatives are computed from the functions of orders α and α+1, found by a single
+// AMOS sequence call, using the recurrences
+//    C'(α,z) = (α/z) C(α,z) - C(α+1,z)   for C = J, Y, K, H1 and H2
+//    I'(α,z) = (α/z) I(α,z) + I(α+1,z)
+// The exponentially scaled functions of orders α and α+1 share the same scaling factor,
+// so the scaled derivatives follow from the same recurrences.
+
+// Id computes the derivative of the bessel function I for complex arguments
+func Id(α float64, z complex128) complex128 {
+	ζ, _, _ := IdE(α, z)
+	return ζ
+}
+
+// IdE computes the derivative of the bessel function I for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func IdE(α float64, z complex128) (complex128, int, error) {
+	return besid(α, z, 1)
+}
+
+// Idx computes the expontentially scaled derivative of the bessel function I for complex arguments
+// Idx = exp(-|Re(z)|) * I'(α,z)
+func Idx(α float64, z complex128) complex128 {
+	ζ, _, _ := IdxE(α, z)
+	return ζ
+}
+
+// IdxE computes the expontentially scaled derivative of the bessel function I for complex arguments and
+// returns the number of components set to zero due to underflow and the AMOS error, if any
+func IdxE(α float64, z complex128) (complex128, int, error) {
+	return besid(α, z, 2)
+}
+
+func besid(α float64, z complex128, kode int) (complex128, int, error) {
+	if z == 0 {
+		return originSlope(α, 1)
+	}
+	return derivative(α, z, kode, 1, besi, zbesi)
+}
+
+// Jd computes the derivative of the bessel function J for complex arguments
+func Jd(α float64, z complex128) complex128 {
+	ζ, _, _ := JdE(α, z)
+	return ζ
+}
+
+// JdE computes the derivative of the bessel function J for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func JdE(α float64, z complex128) (complex128, int, error) {
+	return besjd(α, z, 1)
+}
+
+// Jdx computes the expontentially scaled derivative of the bessel function J for complex arguments
+// Jdx = exp(-|Im(z)|) * J'(α,z)
+func Jdx(α float64, z complex128) complex128 {
+	ζ, _, _ := JdxE(α, z)
+	return ζ
+}
+
+// JdxE computes the expontentially scaled derivative of the bessel function J for complex arguments and
+// returns the number of components set to zero due to underflow and the AMOS error, if any
+func JdxE(α float64, z complex128) (complex128, int, error) {
+	return besjd(α, z, 2)
+}
+
+func besjd(α float64, z complex128, kode int) (complex128, int, error) {
+	if z == 0 {
+		return originSlope(α, -1)
+	}
+	return derivative(α, z, kode, -1, besj, zbesj)
+}
+
+// Kd computes the derivative of the bessel function K for complex arguments
+func Kd(α float64, z complex128) complex128 {
+	ζ, _, _ := KdE(α, z)
+	return ζ
+}
+
+// KdE computes the derivative of the bessel function K for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func KdE(α float64, z complex128) (complex128, int, error) {
+	return beskd(α, z, 1)
+}
+
+// Kdx computes the expontentially scaled derivative of the bessel function K for complex arguments
+// Kdx = exp(z) * K'(α,z)
+func Kdx(α float64, z complex128) complex128 {
+	ζ, _, _ := KdxE(α, z)
+	return ζ
+}
+
+// KdxE computes the expontentially scaled derivative of the bessel function K for complex arguments and
+// returns the number of components set to zero due to underflow and the AMOS error, if any
+func KdxE(α float64, z complex128) (complex128, int, error) {
+	return beskd(α, z, 2)
+}
+
+func beskd(α float64, z complex128, kode int) (complex128, int, error) {
+	return derivative(α, z, kode, -1, besk, zbesk)
+}
+
+// Yd computes the derivative of the bessel function Y for complex arguments
+func Yd(α float64, z complex128) complex128 {
+	ζ, _, _ := YdE(α, z)
+	return ζ
+}
+
+// YdE computes the derivative of the bessel function Y for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func YdE(α float64, z complex128) (complex128, int, error) {
+	return besyd(α, z, 1)
+}
+
+// Ydx computes the expontentially scaled derivative of the bessel function Y for complex arguments
+// Ydx = exp(-|Im(z)|) * Y'(α,z)
+func Ydx(α float64, z complex128) complex128 {
+	ζ, _, _ := YdxE(α, z)
+	return ζ
+}
+
+// YdxE computes the expontentially scaled derivative of the bessel function Y for complex arguments and
+// returns the number of components set to zero due to underflow and the AMOS error, if any
+func YdxE(α float64, z complex128) (complex128, int, error) {
+	return besyd(α, z, 2)
+}
+
+func besyd(α float64, z complex128, kode int) (complex128, int, error) {
+	return derivative(α, z, kode, -1, besy, zbesy)
+}
+
+// H1d computes the derivative of the hankel function of order 1 for complex arguments
+func H1d(α float64, z complex128) complex128 {
+	ζ, _, _ := H1dE(α, z)
+	return ζ
+}
+
+// H1dE computes the derivative of the hankel function of order 1 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H1dE(α float64, z complex128) (complex128, int, error) {
+	return derivative(α, z, 1, -1, besh1, zbesh1)
+}
+
+// H1dx computes the expontentially scaled derivative of the hankel function of order 1 for complex arguments
+// H1dx = exp(-iz) * H1'(α,z)
+func H1dx(α float64, z complex128) complex128 {
+	ζ, _, _ := H1dxE(α, z)
+	return ζ
+}
+
+// H1dxE computes the expontentially scaled derivative of the hankel function of order 1 for complex arguments
+// and returns the number of components set to zero due to underflow and the AMOS error, if any
+func H1dxE(α float64, z complex128) (complex128, int, error) {
+	return derivative(α, z, 2, -1, besh1, zbesh1)
+}
+
+// H2d computes the derivative of the hankel function of order 2 for complex arguments
+func H2d(α float64, z complex128) complex128 {
+	ζ, _, _ := H2dE(α, z)
+	return ζ
+}
+
+// H2dE computes the derivative of the hankel function of order 2 for complex arguments and returns the
+// number of components set to zero due to underflow and the AMOS error, if any
+func H2dE(α float64, z complex128) (complex128, int, error) {
+	return derivative(α, z, 1, -1, besh2, zbesh2)
+}
+
+// H2dx computes the expontentially scaled derivative of the hankel function of order 2 for complex arguments
+// H2dx = exp(iz) * H2'(α,z)
+func H2dx(α float64, z complex128) complex128 {
+	ζ, _, _ := H2dxE(α, z)
+	return ζ
+}
+
+// H2dxE computes the expontentially scaled derivative of the hankel function of order 2 for complex arguments
+// and returns the number of components set to zero due to underflow and the AMOS error, if any
+func H2dxE(α float64, z complex128) (complex128, int, error) {
+	return derivative(α, z, 2, -1, besh2, zbesh2)
+}
+
+// derivative computes (α/z) C(α,z) + σ C(α+1,z) from the two member sequence of orders α and α+1
+func derivative(α float64, z complex128, kode int, σ float64,
+	single func(float64, complex128, int) (complex128, int, error),
+	multi func(float64, complex128, int, int, []complex128) (int, error)) (complex128, int, error) {
+	if z == 0 {
+		// Y, K and the hankel functions are unbounded at the origin
+		return cmplx.Inf(), 0, ErrOverflow
+	}
+	var cy [2]complex128
+	NZ, err := sequence(α, 2, z, kode, cy[:], single, multi)
+	return complex(α, 0)/z*cy[0] + complex(σ, 0)*cy[1], NZ, err
+}
+
+// originSlope computes the derivative at z = 0 of J (σ = -1) or I (σ = 1) from the leading
+// term of the power series, which is unbounded for 0 < |α| < 1 and for negative non-integer α
+func originSlope(α float64, σ float64) (complex128, int, error) {
+	if α < 0 && α == math.Trunc(α) {
+		// J(-n,z) = (-1)^n J(n,z) and I(-n,z) = I(n,z)
+		ζ, NZ, err := originSlope(-α, σ)
+		if σ < 0 && math.Mod(α, 2) != 0 {
+			ζ = -ζ
+		}
+		return ζ, NZ, err
+	}
+	switch {
+	case α == 1:
+		return 0.5, 0, nil
+	case α == 0 || α > 1:
+		return 0, 0, nil
+	}
+	return cmplx.Inf(), 0, ErrOverflow
+}
@@ -0,0 +1,66 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+// Error is the AMOS error flag IERR reported by the error returning
+// variants of the bessel functions
+type Error int
+
+// The AMOS error flags
+const (
+	// ErrInput indicates an input error, no computation was done
+	ErrInput Error = 1
+	// ErrOverflow indicates an overflow, no computation was done
+	ErrOverflow Error = 2
+	// ErrPrecisionLoss indicates |z| or the order is large and the computation
+	// was done, but argument reduction lost more than half of machine accuracy
+	ErrPrecisionLoss Error = 3
+	// ErrTotalLoss indicates |z| or the order is too large, no computation
+	// was done because of a complete loss of significance by argument reduction
+	ErrTotalLoss Error = 4
+	// ErrNoConvergence indicates the algorithm termination condition was not met
+	ErrNoConvergence Error = 5
+)
+
+var errorText = map[Error]string{
+	ErrInput:         "bessel: input error, no computation",
+	ErrOverflow:      "bessel: overflow, no computation",
+	ErrPrecisionLoss: "bessel: less than half of machine accuracy by argument reduction",
+	ErrTotalLoss:     "bessel: complete loss of significance by argument reduction, no computation",
+	ErrNoConvergence: "bessel: algorithm termination condition not met, no computation",
+}
+
+func (e Error) Error() string {
+	if s, ok := errorText[e]; ok {
+		return s
+	}
+	return "bessel: unknown error"
+}
+
+// ierr maps an AMOS IERR flag to an error
+func ierr(IERR int) error {
+	if IERR == 0 {
+		return nil
+	}
+	return Error(IERR)
+}
+
+// worst returns the more severe of two errors, where an error that
+// prevents any computation is more severe than a loss of precision
+func worst(a, b error) error {
+	rank := func(e error) int {
+		switch e {
+		case nil:
+			return 0
+		case ErrPrecisionLoss:
+			return 1
+		}
+		return 2
+	}
+	if rank(b) > rank(a) {
+		return b
+	}
+	return a
+}
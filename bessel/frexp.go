@@ -0,0 +1,301 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"github.com/dreading/gospecfunc/bessel/internal/amos"
+	"math"
+	"math/cmplx"
+)
+
+// The Frexp variants return a mantissa m and binary exponent e with f = m × 2^e, where the
+// larger of |Re(m)| and |Im(m)| lies in [½, 1), in the style of math.Frexp. They are computed
+// from the exponentially scaled AMOS functions, whose known scaling factor exp(s) is folded
+// into the binary exponent, so results far outside the float64 range can still be multiplied
+// and divided exactly. Where the scaled functions themselves overflow, which only happens for
+// large orders, K, Y and the hankel functions are found by forward recurrence from the highest
+// order at which they do not, and where they underflow I and J are found from that order by
+// the product of the ratios computed by AMOS ZRATI. Both directions are stable for large orders.
+// Zero, infinite and NaN results are returned with a zero exponent.
+
+// The kinds of bessel function
+const (
+	frexpI = iota
+	frexpJ
+	frexpK
+	frexpY
+	frexpH1
+	frexpH2
+)
+
+// ln2Hi + ln2Lo = log(2), with ln2Hi exactly representable in few bits as in math.Exp
+const (
+	ln2Hi = 6.93147180369123816490e-01
+	ln2Lo = 1.90821492927058770002e-10
+)
+
+// IFrexp computes the bessel function I for complex arguments as I(α,z) = m × 2^e
+func IFrexp(α float64, z complex128) (complex128, int) {
+	if α >= 0 {
+		return besselFrexp(α, z, frexpI)
+	}
+	// I(-α,z) = I(α,z) + (2/π) sin(απ) K(α,z)
+	s, _ := sincospi(-α)
+	m, e := besselFrexp(-α, z, frexpI)
+	km, ke := besselFrexp(-α, z, frexpK)
+	return frexpAdd(m, e, complex(2*s/math.Pi, 0)*km, ke)
+}
+
+// JFrexp computes the bessel function J for complex arguments as J(α,z) = m × 2^e
+func JFrexp(α float64, z complex128) (complex128, int) {
+	if α >= 0 {
+		return besselFrexp(α, z, frexpJ)
+	}
+	// J(-α,z) = cos(απ) J(α,z) - sin(απ) Y(α,z)
+	s, c := sincospi(-α)
+	jm, je := besselFrexp(-α, z, frexpJ)
+	ym, ye := besselFrexp(-α, z, frexpY)
+	return frexpAdd(complex(c, 0)*jm, je, complex(-s, 0)*ym, ye)
+}
+
+// KFrexp computes the bessel function K for complex arguments as K(α,z) = m × 2^e
+func KFrexp(α float64, z complex128) (complex128, int) {
+	// K(-α,z) = K(α,z)
+	return besselFrexp(math.Abs(α), z, frexpK)
+}
+
+// YFrexp computes the bessel function Y for complex arguments as Y(α,z) = m × 2^e
+func YFrexp(α float64, z complex128) (complex128, int) {
+	if α >= 0 {
+		return besselFrexp(α, z, frexpY)
+	}
+	// Y(-α,z) = sin(απ) J(α,z) + cos(απ) Y(α,z)
+	s, c := sincospi(-α)
+	jm, je := besselFrexp(-α, z, frexpJ)
+	ym, ye := besselFrexp(-α, z, frexpY)
+	return frexpAdd(complex(s, 0)*jm, je, complex(c, 0)*ym, ye)
+}
+
+// H1Frexp computes the hankel function of order 1 for complex arguments as H1(α,z) = m × 2^e
+func H1Frexp(α float64, z complex128) (complex128, int) {
+	if α >= 0 {
+		return besselFrexp(α, z, frexpH1)
+	}
+	// H1(-α,z) = exp(απi) H1(α,z)
+	s, c := sincospi(-α)
+	m, e := besselFrexp(-α, z, frexpH1)
+	return normalize(complex(c, s)*m, e)
+}
+
+// H2Frexp computes the hankel function of order 2 for complex arguments as H2(α,z) = m × 2^e
+func H2Frexp(α float64, z complex128) (complex128, int) {
+	if α >= 0 {
+		return besselFrexp(α, z, frexpH2)
+	}
+	// H2(-α,z) = exp(-απi) H2(α,z)
+	s, c := sincospi(-α)
+	m, e := besselFrexp(-α, z, frexpH2)
+	return normalize(complex(c, -s)*m, e)
+}
+
+// AiFrexp computes the Airy Ai function as Ai(z) = m × 2^e
+func AiFrexp(z complex128) (complex128, int) {
+	return airyFrexp(z, 0, false)
+}
+
+// AidFrexp computes the first derivative of the Airy Ai function as dAi(z)/dz = m × 2^e
+func AidFrexp(z complex128) (complex128, int) {
+	return airyFrexp(z, 1, false)
+}
+
+// BiFrexp computes the Airy Bi function as Bi(z) = m × 2^e
+func BiFrexp(z complex128) (complex128, int) {
+	return airyFrexp(z, 0, true)
+}
+
+// BidFrexp computes the first derivative of the Airy Bi function as dBi(z)/dz = m × 2^e
+func BidFrexp(z complex128) (complex128, int) {
+	return airyFrexp(z, 1, true)
+}
+
+// airyFrexp computes Ai, Ai', Bi or Bi' from the scaled AMOS functions
+//    Aix = exp(ζ) Ai(z) and Bix = exp(-|Re(ζ)|) Bi(z) with ζ = (2/3) z^(3/2)
+func airyFrexp(z complex128, id int, bi bool) (complex128, int) {
+	var ζr, ζi float64
+	var IERR int
+	if bi {
+		ζr, ζi, IERR = amos.ZBIRY(real(z), imag(z), id, 2)
+	} else {
+		ζr, ζi, _, IERR = amos.ZAIRY(real(z), imag(z), id, 2)
+	}
+	if IERR != 0 && IERR != 3 {
+		return cmplx.NaN(), 0
+	}
+	ζ := 2.0 / 3 * z * cmplx.Sqrt(z)
+	if bi {
+		return expFrexp(complex(ζr, ζi), 0, complex(math.Abs(real(ζ)), 0))
+	}
+	return expFrexp(complex(ζr, ζi), 0, -ζ)
+}
+
+// besselFrexp computes the bessel function of the given kind and order ν ≥ 0 as m × 2^e
+func besselFrexp(ν float64, z complex128, kind int) (complex128, int) {
+	var multi func(float64, complex128, int, int, []complex128) (int, error)
+	var s complex128
+	switch kind {
+	case frexpI:
+		multi, s = zbesi, complex(math.Abs(real(z)), 0)
+	case frexpJ:
+		multi, s = zbesj, complex(math.Abs(imag(z)), 0)
+	case frexpK:
+		multi, s = zbesk, -z
+	case frexpY:
+		multi, s = zbesy, complex(math.Abs(imag(z)), 0)
+	case frexpH1:
+		multi, s = zbesh1, 1i*z
+	case frexpH2:
+		multi, s = zbesh2, -1i*z
+	}
+	dominant := kind != frexpI && kind != frexpJ
+	if z == 0 && dominant {
+		return cmplx.Inf(), 0
+	}
+
+	var cy [2]complex128
+	NZ, err := multi(ν, z, 2, 1, cy[:1])
+	if dominant && err != ErrOverflow || !dominant && NZ == 0 {
+		if err != nil && err != ErrPrecisionLoss {
+			return cmplx.NaN(), 0
+		}
+		return expFrexp(cy[0], 0, s)
+	}
+	if !dominant && cmplx.Abs(z) < 1e-8 {
+		// AMOS and its ratios underflow for tiny |z|, where the leading term of the series does not
+		return seriesFrexp(ν, z)
+	}
+
+	// ok reports whether the scaled functions of orders ν-j and, for the recurrence, ν-j+1
+	// are representable. The orders at which they are form an interval [0, ν-j₀].
+	n := int(math.Floor(ν))
+	ok := func(j int) bool {
+		if dominant {
+			NZ, err = multi(ν-float64(j), z, 2, 2, cy[:])
+		} else {
+			NZ, err = multi(ν-float64(j), z, 2, 1, cy[:1])
+		}
+		return (err == nil || err == ErrPrecisionLoss) && NZ == 0 && cy[0] != 0 &&
+			cmplx.IsInf(cy[0]) == false && cmplx.IsInf(cy[1]) == false
+	}
+	if n < 1 || (dominant && n < 2) || ok(n) == false {
+		if dominant {
+			return cmplx.Inf(), 0
+		}
+		return 0, 0
+	}
+	lo, hi := 0, n
+	if dominant {
+		lo = 1
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		if ok(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	ok(hi)
+	ν0 := ν - float64(hi)
+
+	var m complex128
+	var e int
+	if dominant {
+		// C(ν0+k+1,z) = 2(ν0+k)/z C(ν0+k,z) - C(ν0+k-1,z), with + for K
+		big := math.Ldexp(1, 500)
+		σ := complex(-1, 0)
+		if kind == frexpK {
+			σ = 1
+		}
+		a, b := cy[0], cy[1]
+		for k := 1; k < hi; k++ {
+			a, b = b, complex(2*(ν0+float64(k)), 0)/z*b+σ*a
+			if math.Abs(real(b))+math.Abs(imag(b)) > big {
+				a, b = a/complex(big, 0), b/complex(big, 0)
+				e += 500
+			}
+		}
+		m, e = normalize(b, e)
+	} else {
+		m, e = normalize(cy[0], 0)
+		// I(ν0+k+1,z) = I(ν0+k,z) r(k) with the ratios r(k) of I at z, or of J using
+		// J(ν+1,z)/J(ν,z) = i I(ν+1,-iz)/I(ν,-iz)
+		r := make([]complex128, hi)
+		if kind == frexpI {
+			zrati(ν0, z, r)
+		} else {
+			zrati(ν0, -1i*z, r)
+			for k := range r {
+				r[k] *= 1i
+			}
+		}
+		for _, rk := range r {
+			m, e = normalize(m*rk, e)
+		}
+	}
+	return expFrexp(m, e, s)
+}
+
+// seriesFrexp computes I or J for |z| < 1e-8 from the leading term (z/2)^ν / Γ(ν+1) of their
+// power series, whose next term is smaller by (z/2)²/(ν+1), as m^ν 2^(kν) / Γ(ν+1) with z/2 = m × 2^k
+func seriesFrexp(ν float64, z complex128) (complex128, int) {
+	m, k := normalize(z, 0)
+	k--
+	// kν = p + q exactly
+	p := float64(k) * ν
+	q := math.FMA(float64(k), ν, -p)
+	f := math.Floor(p)
+	w := cmplx.Pow(m, complex(ν, 0)) * complex(math.Exp2((p-f)+q), 0)
+	if g := math.Gamma(ν + 1); math.IsInf(g, 1) == false {
+		return normalize(w/complex(g, 0), int(f))
+	}
+	lg, _ := math.Lgamma(ν + 1)
+	return expFrexp(w, int(f), complex(-lg, 0))
+}
+
+// normalize returns the mantissa and exponent of ζ × 2^e, or ζ and 0 for zero, infinite or NaN ζ
+func normalize(ζ complex128, e int) (complex128, int) {
+	if ζ == 0 || cmplx.IsInf(ζ) || cmplx.IsNaN(ζ) {
+		return ζ, 0
+	}
+	_, k := math.Frexp(math.Max(math.Abs(real(ζ)), math.Abs(imag(ζ))))
+	return complex(math.Ldexp(real(ζ), -k), math.Ldexp(imag(ζ), -k)), e + k
+}
+
+// expFrexp returns the mantissa and exponent of m × 2^e × exp(s), splitting Re(s) = k log(2) + r
+// with |r| ≤ log(2)/2 so that only exp(r) is evaluated
+func expFrexp(m complex128, e int, s complex128) (complex128, int) {
+	if m == 0 || cmplx.IsInf(m) || cmplx.IsNaN(m) || cmplx.IsInf(s) || cmplx.IsNaN(s) {
+		return normalize(m*cmplx.Exp(s), e)
+	}
+	k := math.Round(real(s) / math.Ln2)
+	r := real(s) - k*ln2Hi - k*ln2Lo
+	sin, cos := math.Sincos(imag(s))
+	f := math.Exp(r)
+	return normalize(m*complex(f*cos, f*sin), e+int(k))
+}
+
+// frexpAdd returns the mantissa and exponent of a × 2^ea + b × 2^eb
+func frexpAdd(a complex128, ea int, b complex128, eb int) (complex128, int) {
+	if a == 0 || cmplx.IsInf(b) || cmplx.IsNaN(b) {
+		return normalize(b, eb)
+	}
+	if b == 0 || cmplx.IsInf(a) || cmplx.IsNaN(a) {
+		return normalize(a, ea)
+	}
+	if ea < eb {
+		a, ea, b, eb = b, eb, a, ea
+	}
+	return normalize(a+complex(math.Ldexp(real(b), eb-ea), math.Ldexp(imag(b), eb-ea)), ea)
+}
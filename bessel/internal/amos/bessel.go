@@ -942,7 +942,7 @@ func ZUNK1(ZR float64, ZI float64, FNU float64, KODE int, MR int, N int, YR []fl
 	)
 
 	var ANG, APHI, ASC, ASCLE, CKI, CKR, CRSC, CSCL, CSGNI, CSPNI, CSPNR, CSR,
-		C1I, C1R, C2I, C2M, C2R, FMR, FN, FNF, PHIDI, PHIDR, PI, RAST, RAZR, RS1, RZI,
+		C1I, C1R, C2I, C2M, C2R, FMR, FN, FNF, PHIDI, PHIDR, RAST, RAZR, RS1, RZI,
 		RZR, SGN, STI, STR, SUMDI, SUMDR, S1I, S1R, S2I, S2R, ZET1DI, ZET1DR, ZET2DI, ZET2DR, ZRI, ZRR float64
 
 	var I, IB, IFLAG, IFN, IL, INU, IUF, K, KDFLG, KFLAG, KK, NW, INITD, IC, IPARD, J, M int
@@ -1223,7 +1223,7 @@ L160:
 	// ANALYTIC CONTINUATION FOR RE(Z) < 0.0e0
 	NZ = 0
 	FMR = float64(float32(MR))
-	SGN = -math.Copysign(PI, FMR)
+	SGN = -math.Copysign(math.Pi, FMR)
 
 	// CSPN AND CSGN ARE COEFF OF K AND I FUNCTIONS RESP.
 	CSGNI = SGN
@@ -1490,7 +1490,7 @@ func ZUNK2(ZR float64, ZI float64, FNU float64, KODE int, MR int, N int, YR []fl
 		STI, STR, S1I, S1R, S2I, S2R, YY, ZBI, ZBR,
 		ZET1DI, ZET1DR, ZET2DI, ZET2DR, ZNI, ZNR, ZRI, ZRR float64
 
-	var I, IB, IFLAG, IFN, IL, IN, INU, IUF, K, KDFLG, KFLAG, KK, NAI, NDAI, NW, IDUM, J, IPARD, IC int
+	var I, IB, IFLAG, IFN, IL, IN, INU, IUF, K, KDFLG, KFLAG, KK, NW, J, IPARD, IC int
 
 	BRY := []float64{math.NaN(), 0, 0, 0}
 	ASUMR := []float64{math.NaN(), 0, 0}
@@ -1616,8 +1616,8 @@ L20:
 		// EXPONENT EXTREMES
 		C2R = ARGR[J]*CR2R - ARGI[J]*CR2I
 		C2I = ARGR[J]*CR2I + ARGI[J]*CR2R
-		AIR, AII, NAI, IDUM = ZAIRY(C2R, C2I, 0, 2)
-		DAIR, DAII, NDAI, IDUM = ZAIRY(C2R, C2I, 1, 2)
+		AIR, AII, _, _ = ZAIRY(C2R, C2I, 0, 2)
+		DAIR, DAII, _, _ = ZAIRY(C2R, C2I, 1, 2)
 		STR = DAIR*BSUMR[J] - DAII*BSUMI[J]
 		STI = DAIR*BSUMI[J] + DAII*BSUMR[J]
 		PTR = STR*CR2R - STI*CR2I
@@ -1905,8 +1905,8 @@ L190:
 			IFLAG = 3
 		}
 	L240:
-		AIR, AII, NAI, IDUM = ZAIRY(AIR, AII, NAI, IDUM)
-		DAIR, DAII, NDAI, IDUM = ZAIRY(DAIR, DAII, NDAI, IDUM)
+		AIR, AII, _, _ = ZAIRY(ARGDR, ARGDI, 0, 2)
+		DAIR, DAII, _, _ = ZAIRY(ARGDR, ARGDI, 1, 2)
 		STR = DAIR*BSUMDR - DAII*BSUMDI
 		STI = DAIR*BSUMDI + DAII*BSUMDR
 		STR = STR + (AIR*ASUMDR - AII*ASUMDI)
@@ -1994,7 +1994,7 @@ L295:
 	CSR = CSRR[IFLAG]
 	ASCLE = BRY[IFLAG]
 	FN = float64(float32(INU + IL))
-	for I = 1; I < IL; I++ {
+	for I = 1; I <= IL; I++ {
 		C2R = S2R
 		C2I = S2I
 		S2R = S1R + (FN+FNF)*(RZR*C2R-RZI*C2I)
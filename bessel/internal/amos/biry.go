@@ -576,7 +576,7 @@ L40:
 	// COMPUTE SUM FOR THE I FUNCTION
 	SR = ZEROR
 	SI = ZEROI
-	for I = 1; I < INIT; I++ {
+	for I = 1; I <= INIT; I++ {
 		SR = SR + CWRKR[I]
 		SI = SI + CWRKI[I]
 	}
@@ -590,7 +590,7 @@ L60:
 	SR = ZEROR
 	SI = ZEROI
 	TR = CONER
-	for I = 1; I < INIT; I++ {
+	for I = 1; I <= INIT; I++ {
 		SR = SR + TR*CWRKR[I]
 		SI = SI + TR*CWRKI[I]
 		TR = -TR
@@ -612,7 +612,7 @@ func ZBUNI(ZR float64, ZI float64, FNU float64, KODE int, N int, YR []float64, Y
 	var AX, AY, CSCLR, CSCRR, DFNU, FNUI, GNU, RAZ, RZI, RZR, STI, STR, S1I, S1R, S2I, S2R, ASCLE, C1R, C1I, C1M float64
 
 	var I, IFLAG, IFORM, K, NL, NW int
-	var CYR, CYI []float64
+	var CYR, CYI = make([]float64, 3), make([]float64, 3)
 	var BRY [4]float64
 
 	NZ = 0
@@ -807,7 +807,7 @@ func ZUNI1(ZR float64, ZI float64, FNU float64, KODE int, N int, YR []float64, Y
 
 	var CYR, CYI [3]float64
 	var CSRR, CSSR, BRY [4]float64
-	var CWRKR, CWRKI []float64
+	var CWRKR, CWRKI = make([]float64, 17), make([]float64, 17)
 
 	NZ = 0
 	ND = N
@@ -1347,6 +1347,15 @@ L20:
 		P2R = PTR
 		P2I = PTI
 		T1R = T1R - CONER
+		// RESCALE TO AVOID OVERFLOW WHEN THE RECURRENCE STARTS FAR ABOVE
+		// THE ORDER FNU, AS IT DOES FOR LARGE CABS(Z). ONLY THE RATIO
+		// P2/P1 IS USED. THIS TEST IS NOT IN THE FORTRAN ORIGINAL.
+		if math.Abs(P1R)+math.Abs(P1I) > 1.0e+200 {
+			P1R = P1R * 1.0e-200
+			P1I = P1I * 1.0e-200
+			P2R = P2R * 1.0e-200
+			P2I = P2I * 1.0e-200
+		}
 	}
 	if P1R != CZEROR || P1I != CZEROI {
 		goto L40
@@ -1707,7 +1716,7 @@ func ZUNHJ(ZR float64, ZI float64, FNU float64, IPMTR int, TOL float64, PHIR flo
 		ZEROR = 0.0e0
 		ZEROI = 0.0e0
 		CONER = 1.0e0
-		CONEI = 1.0e0
+		CONEI = 0.0e0
 		EX1   = 3.33333333333333333e-01
 		EX2   = 6.66666666666666667e-01
 		HPI   = 1.57079632679489662e+00
@@ -0,0 +1,864 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+//
+// The Airy functions for real arguments follow the scheme of the Chebyshev
+// expansions in bessel01.go. For |x| <= 1 they are computed from the Maclaurin
+// series
+//    Ai(x) = Ai(0) f(x) + Ai'(0) g(x), Bi(x) = √3 (Ai(0) f(x) - Ai'(0) g(x))
+// with f and g expanded in x^3, for x > 1 from the asymptotic forms
+//    Ai(x) = a(x) exp(-ζ) / (2 √π x^(1/4)), Bi(x) = b(x) exp(ζ) / (√π x^(1/4))
+// with ζ = (2/3) x^(3/2), and for x < -1 from the moduli and phases
+//    Ai(x) = M(x) cos(θ(x)), Bi(x) = M(x) sin(θ(x))
+// with θ(x) = π/4 - ζ + δ(x) and ζ = (2/3) (-x)^(3/2), see DLMF 9.8. The slowly varying factors a, b, M
+// and δ and those of the derivatives are expanded in s = |x|^(-3/2) on [1,4] and
+// (4,∞). The coefficients were computed to 20 decimal places from the power
+// series and the asymptotic expansions DLMF 9.7. ζ is formed to twice the
+// working precision, so that exp(ζ) and the phases do not lose the accuracy
+// that a rounded ζ would for large |x|. AIRYMP returns the moduli and phases
+// themselves, which keep their relative accuracy near the zeros of Ai and Bi.
+
+package toms
+
+import (
+	"github.com/dreading/gospecfunc/utils"
+	"math"
+)
+
+const (
+	ai0        = 0.35502805388781723926e0  // Ai(0)
+	aid0       = -0.25881940379280679841e0 // Ai'(0)
+	rtpiin     = 0.56418958354775628695e0  // 1/√π
+	rt3        = 0.17320508075688772935e1  // √3
+	twothirdlo = 0.37007434154171884681e-16
+	// the argument beyond which ζ is not formed to twice the working precision
+	airyxmax = 1e200
+)
+
+// AIRYAI calculates the Airy function Ai(x)
+func AIRYAI(X float64) float64 {
+	return airyai(X, 0, false)
+}
+
+// AIRYAIE calculates the exponentially scaled Airy function
+// exp((2/3) x^(3/2)) Ai(x) for x > 0 and Ai(x) for x <= 0
+func AIRYAIE(X float64) float64 {
+	return airyai(X, 0, true)
+}
+
+// AIRYAID calculates the derivative of the Airy function Ai'(x)
+func AIRYAID(X float64) float64 {
+	return airyai(X, 1, false)
+}
+
+// AIRYAIDE calculates the exponentially scaled derivative of the Airy function
+// exp((2/3) x^(3/2)) Ai'(x) for x > 0 and Ai'(x) for x <= 0
+func AIRYAIDE(X float64) float64 {
+	return airyai(X, 1, true)
+}
+
+// AIRYBI calculates the Airy function Bi(x)
+func AIRYBI(X float64) float64 {
+	return airybi(X, 0, false)
+}
+
+// AIRYBIE calculates the exponentially scaled Airy function
+// exp(-(2/3) x^(3/2)) Bi(x) for x > 0 and Bi(x) for x <= 0
+func AIRYBIE(X float64) float64 {
+	return airybi(X, 0, true)
+}
+
+// AIRYBID calculates the derivative of the Airy function Bi'(x)
+func AIRYBID(X float64) float64 {
+	return airybi(X, 1, false)
+}
+
+// AIRYBIDE calculates the exponentially scaled derivative of the Airy function
+// exp(-(2/3) x^(3/2)) Bi'(x) for x > 0 and Bi'(x) for x <= 0
+func AIRYBIDE(X float64) float64 {
+	return airybi(X, 1, true)
+}
+
+// airyai calculates Ai (ID=0) or Ai' (ID=1), scaled by exp(ζ) for x > 0 if SCALE
+func airyai(X float64, ID int, SCALE bool) float64 {
+	var RET float64
+	switch {
+	case math.IsNaN(X):
+		return X
+	case math.IsInf(X, 1):
+		if ID == 1 && SCALE {
+			return math.Inf(-1)
+		}
+		return 0
+	case X > 1:
+		Q := math.Sqrt(math.Sqrt(X))
+		if ID == 0 {
+			RET = rtpiin / (2 * Q) * airycheb(X, araip1, araip2)
+		} else {
+			RET = -rtpiin * Q / 2 * airycheb(X, araidp1, araidp2)
+		}
+		if !SCALE {
+			ZH, ZL := airyzeta(X)
+			RET *= math.Exp(-ZH) * (1 - ZL)
+		}
+	case X >= -1:
+		T := X * X * X
+		if ID == 0 {
+			RET = ai0*utils.Cheval(len(arairf)-1, arairf, T) + aid0*X*utils.Cheval(len(arairg)-1, arairg, T)
+		} else {
+			RET = ai0*X*X*utils.Cheval(len(arairf1)-1, arairf1, T) + aid0*utils.Cheval(len(arairg1)-1, arairg1, T)
+		}
+		if SCALE && X > 0 {
+			RET *= math.Exp(2.0 / 3 * X * math.Sqrt(X))
+		}
+	case X > -airyxmax:
+		RET, _ = airyneg(-X, ID)
+	default:
+		// the phase is lost, and only Ai itself has a limit as x → -∞
+		if ID == 1 {
+			return math.NaN()
+		}
+		return 0
+	}
+	return RET
+}
+
+// airybi calculates Bi (ID=0) or Bi' (ID=1), scaled by exp(-ζ) for x > 0 if SCALE
+func airybi(X float64, ID int, SCALE bool) float64 {
+	var RET float64
+	switch {
+	case math.IsNaN(X):
+		return X
+	case math.IsInf(X, 1):
+		if ID == 0 && SCALE {
+			return 0
+		}
+		return math.Inf(1)
+	case X > 1:
+		Q := math.Sqrt(math.Sqrt(X))
+		if ID == 0 {
+			RET = rtpiin / Q * airycheb(X, arbip1, arbip2)
+		} else {
+			RET = rtpiin * Q * airycheb(X, arbidp1, arbidp2)
+		}
+		if !SCALE {
+			// exp(ζ) may overflow before Bi does
+			ZH, ZL := airyzeta(X)
+			E := math.Exp(ZH / 2)
+			RET = RET * E * (1 + ZL) * E
+		}
+	case X >= -1:
+		T := X * X * X
+		if ID == 0 {
+			RET = rt3 * (ai0*utils.Cheval(len(arairf)-1, arairf, T) - aid0*X*utils.Cheval(len(arairg)-1, arairg, T))
+		} else {
+			RET = rt3 * (ai0*X*X*utils.Cheval(len(arairf1)-1, arairf1, T) - aid0*utils.Cheval(len(arairg1)-1, arairg1, T))
+		}
+		if SCALE && X > 0 {
+			RET *= math.Exp(-2.0 / 3 * X * math.Sqrt(X))
+		}
+	case X > -airyxmax:
+		_, RET = airyneg(-X, ID)
+	default:
+		if ID == 1 {
+			return math.NaN()
+		}
+		return 0
+	}
+	return RET
+}
+
+// AIRYMP calculates the modulus and phase of the Airy functions (ID=0)
+//    Ai(x) = M(x) cos(θ(x)), Bi(x) = M(x) sin(θ(x))
+// or of their derivatives (ID=1)
+//    Ai'(x) = N(x) cos(φ(x)), Bi'(x) = N(x) sin(φ(x))
+// for x <= 0, where the phases are continuous with θ(0) = π/3 and φ(0) = 2π/3
+func AIRYMP(X float64, ID int) (float64, float64) {
+	switch {
+	case math.IsNaN(X) || X > 0:
+		return math.NaN(), math.NaN()
+	case X <= -1:
+		M, W := airymw(-X, ID)
+		ZH, ZL := airyzeta(-X)
+		return M, (W - ZL) - ZH
+	}
+	A, B := airyai(X, ID, false), airybi(X, ID, false)
+	return math.Hypot(A, B), math.Atan2(B, A)
+}
+
+// airyneg calculates Ai(-y) and Bi(-y), or their derivatives, for y > 1 from
+// the modulus and phase
+func airyneg(Y float64, ID int) (float64, float64) {
+	M, W := airymw(Y, ID)
+	// cos(θ) = cos(ζ - W) and sin(θ) = -sin(ζ - W) with ζ = ZH + ZL
+	ZH, ZL := airyzeta(Y)
+	SZ, CZ := math.Sincos(ZH)
+	SW, CW := math.Sincos(ZL - W)
+	return M * (CZ*CW - SZ*SW), -M * (SZ*CW + CZ*SW)
+}
+
+// airymw calculates the modulus M and W = θ + ζ with θ = π/4 - ζ + δ, or N and
+// W = φ + ζ with φ = 3π/4 - ζ + ε, for y >= 1 where √π y^(1/4) M, δ, √π y^(-1/4) N
+// and ε are Chebyshev expansions in y^(-3/2)
+func airymw(Y float64, ID int) (float64, float64) {
+	Q := math.Sqrt(math.Sqrt(Y))
+	if ID == 0 {
+		return rtpiin / Q * airycheb(Y, armodm1, armodm2), piby4 + airycheb(Y, arthm1, arthm2)
+	}
+	return rtpiin * Q * airycheb(Y, armodn1, armodn2), 3*piby4 + airycheb(Y, arphn1, arphn2)
+}
+
+// airycheb evaluates the Chebyshev expansion A1 on [1,4] or A2 on (4,∞) in s = x^(-3/2)
+func airycheb(X float64, A1, A2 []float64) float64 {
+	S := 1 / (X * math.Sqrt(X))
+	if X <= 4 {
+		return utils.Cheval(len(A1)-1, A1, (16*S-9)/7)
+	}
+	return utils.Cheval(len(A2)-1, A2, 16*S-1)
+}
+
+// airyzeta calculates ζ = (2/3) y^(3/2) as the unevaluated sum ZH + ZL
+func airyzeta(Y float64) (float64, float64) {
+	if Y > airyxmax {
+		return 2.0 / 3 * Y * math.Sqrt(Y), 0
+	}
+	R := math.Sqrt(Y)
+	P, E := twoprod(R, R)
+	RL := ((Y - P) - E) / (2 * R)
+	Q, QE := twoprod(Y, R)
+	QL := QE + Y*RL
+	ZH, ZE := twoprod(Q, 2.0/3)
+	ZL := ZE + Q*twothirdlo + QL*(2.0/3)
+	H := ZH + ZL
+	return H, ZL - (H - ZH)
+}
+
+// twoprod calculates A*B = P + E exactly by Dekker's algorithm
+func twoprod(A, B float64) (float64, float64) {
+	P := A * B
+	AH, AL := veltkamp(A)
+	BH, BL := veltkamp(B)
+	return P, ((AH*BH - P) + AH*BL + AL*BH) + AL*BL
+}
+
+// veltkamp splits A = H + L with H and L of at most 26 significant bits
+func veltkamp(A float64) (float64, float64) {
+	C := 134217729 * A
+	H := C - (C - A)
+	return H, A - H
+}
+
+var arairf = []float64{
+	0.20055559939731377136e1,
+	0.16672453877677863062e0,
+	0.27780700566396685997e-2,
+	0.19290993328771375471e-4,
+	0.73070355083522349423e-7,
+	0.17397534381981764376e-9,
+	0.28427201912486498580e-12,
+	0.33841811596602464533e-15,
+	0.30653762342273423737e-18,
+}
+
+var arairg = []float64{
+	0.20019842329748923917e1,
+	0.83349868092889517498e-1,
+	0.99213415266343766131e-3,
+	0.55116478558972972336e-5,
+	0.17665271046208601393e-7,
+	0.36802383067035860398e-10,
+	0.53804445886597105580e-13,
+	0.58229788500815788526e-16,
+	0.48524755596074930654e-19,
+}
+
+var arairf1 = []float64{
+	0.10006944757598641966e1,
+	0.33338594378431835135e-1,
+	0.34724309919241292568e-3,
+	0.17536987558119386947e-5,
+	0.52192745281671897855e-8,
+	0.10233807402308592445e-10,
+	0.14213572660472579413e-13,
+	0.14713813452956360454e-16,
+	0.11789899544309287004e-19,
+}
+
+var arairg1 = []float64{
+	0.20138902667752957150e1,
+	0.33349868313703815901e0,
+	0.69453630370861350167e-2,
+	0.55117582632910636262e-4,
+	0.22965046056309292491e-6,
+	0.58884057472542382652e-9,
+	0.10222868010346488773e-11,
+	0.12810570800438454644e-14,
+	0.12131199251615208115e-17,
+}
+
+var araip1 = []float64{
+	0.19179031204680107305e1,
+	-0.26712235584567337452e-1,
+	0.21168515418083453516e-2,
+	-0.25818453465265417870e-3,
+	0.39359008500564337743e-4,
+	-0.69139388927385742332e-5,
+	0.13424472892059212235e-5,
+	-0.28109967034440168650e-6,
+	0.62470052268346751158e-7,
+	-0.14572291511721026936e-7,
+	0.35395088278912626365e-8,
+	-0.88980488991061045461e-9,
+	0.23043679356800656450e-9,
+	-0.61249567726106872333e-10,
+	0.16658864253656430109e-10,
+	-0.46249547823752353295e-11,
+	0.13079640553662796494e-11,
+	-0.37614562111354173293e-12,
+	0.10983593016356777983e-12,
+	-0.32524202315576858709e-13,
+	0.97557601933792577867e-14,
+	-0.29613273124936864680e-14,
+	0.90888992073740011128e-15,
+	-0.28184262859315505640e-15,
+	0.88242807282965816619e-16,
+	-0.27878313117643914138e-16,
+	0.88824302486537478888e-17,
+	-0.28527362428635583175e-17,
+	0.92312839332370553876e-18,
+	-0.30085469181080785112e-18,
+	0.98715288737925593518e-19,
+	-0.32598471229025170019e-19,
+}
+
+var araip2 = []float64{
+	0.19878312942416829432e1,
+	-0.59516821728535213039e-2,
+	0.12749381195256430343e-3,
+	-0.48948744996542384373e-5,
+	0.26272119491152298277e-6,
+	-0.17732982617456989715e-7,
+	0.14204229539210100556e-8,
+	-0.13021553517030219750e-9,
+	0.13330073810572013545e-10,
+	-0.14970901449937875528e-11,
+	0.18203436185566890915e-12,
+	-0.23718830332990060682e-13,
+	0.32849509195621372860e-14,
+	-0.48038597793289408020e-15,
+	0.73775022574558003891e-16,
+	-0.11844121511715052556e-16,
+	0.19801095265653481435e-17,
+	-0.34357920401780154927e-18,
+	0.61697594285400686349e-19,
+}
+
+var araidp1 = []float64{
+	0.21212249471818872748e1,
+	0.40648951689856458886e-1,
+	-0.26913200120146362282e-2,
+	0.30823596757179060729e-3,
+	-0.45458937221848563795e-4,
+	0.78210219842425793683e-5,
+	-0.14967646723890108372e-5,
+	0.31006826117778189540e-6,
+	-0.68336457829001343546e-7,
+	0.15834556807520967465e-7,
+	-0.38249937136724292355e-8,
+	0.95713551807101092671e-9,
+	-0.24689576990354382576e-9,
+	0.65399969224221024135e-10,
+	-0.17734399505742400132e-10,
+	0.49104924195537310455e-11,
+	-0.13854273742812672533e-11,
+	0.39757418613512211953e-12,
+	-0.11586939288177590488e-12,
+	0.34250607181552970529e-13,
+	-0.10257107995773277976e-13,
+	0.31089147750664013947e-14,
+	-0.95288758941375311227e-15,
+	0.29511314924936945438e-15,
+	-0.92289280900880045983e-16,
+	0.29124794409016885604e-16,
+	-0.92700778133991967768e-17,
+	0.29743773043448345834e-17,
+	-0.96162201420723059451e-18,
+	0.31313352852682411510e-18,
+	-0.10266166383336605748e-18,
+	0.33875912890046075375e-19,
+}
+
+var araidp2 = []float64{
+	0.20172147299164165903e1,
+	0.84488352198573219483e-2,
+	-0.15267944775308984243e-3,
+	0.55403808628828618703e-5,
+	-0.28905786634172600355e-6,
+	0.19179275773479877876e-7,
+	-0.15186848561347332212e-8,
+	0.13807343282838792520e-9,
+	-0.14046044953832552597e-10,
+	0.15697504326685827023e-11,
+	-0.19011216895509890918e-12,
+	0.24690236817901285492e-13,
+	-0.34100722759200169671e-14,
+	0.49751183244453198682e-15,
+	-0.76249949590807587909e-16,
+	0.12219733096068562013e-16,
+	-0.20397071444096939195e-17,
+	0.35342771114446707679e-18,
+	-0.63387003672435675416e-19,
+}
+
+var arbip1 = []float64{
+	0.21385523321614104107e1,
+	0.43460339045397737742e-1,
+	-0.14068611213611597220e-1,
+	-0.10309664335555022808e-2,
+	0.15518682880333826498e-2,
+	-0.47231568682972296034e-3,
+	0.14883048490952525270e-4,
+	0.60478643638613187638e-4,
+	-0.37208914870789350977e-4,
+	0.13097957986522010380e-4,
+	-0.21936873974968364930e-5,
+	-0.81201452442148142419e-6,
+	0.96421756457618578089e-6,
+	-0.54522525136905285347e-6,
+	0.22133069801455923701e-6,
+	-0.62686774513014317713e-7,
+	0.52591949926087023151e-8,
+	0.81557997312065830967e-8,
+	-0.74576404491659683104e-8,
+	0.43152894216049862380e-8,
+	-0.19755570517908407732e-8,
+	0.73211323194783509645e-9,
+	-0.19763066817495218603e-9,
+	0.13261581708609180116e-10,
+	0.29632349822952661090e-10,
+	-0.27510329556087945501e-10,
+	0.16976665302495428265e-10,
+	-0.86138058464536445792e-11,
+	0.37637897895112032343e-11,
+	-0.14026145841915530284e-11,
+	0.40894220819396689666e-12,
+	-0.55439879680259366792e-13,
+	-0.38756004865900171606e-13,
+	0.45332016033592546048e-13,
+	-0.31131887821964664646e-13,
+	0.17455336348593394617e-13,
+	-0.85965671120359849184e-14,
+	0.37922436895667403065e-14,
+	-0.14857088066954068152e-14,
+	0.49264324526364991427e-15,
+	-0.11472337291325417231e-15,
+	-0.48159071556579557374e-17,
+	0.29042912170004100902e-16,
+	-0.24734032440286902537e-16,
+	0.15701307584781170128e-16,
+	-0.86205886479753103667e-17,
+	0.42814220441535246829e-17,
+	-0.19515906531915893186e-17,
+	0.81466776816215133498e-18,
+	-0.30489860316976924112e-18,
+	0.95950147943285263853e-19,
+}
+
+var arbip2 = []float64{
+	0.20142223386473060272e1,
+	0.73346752855069021642e-2,
+	0.23989642162628962509e-3,
+	0.18481937556248279604e-4,
+	0.23883735469053212970e-5,
+	0.30070342574745418793e-6,
+	-0.17895581839977373067e-7,
+	-0.29651592269006195112e-7,
+	-0.81205619212199270184e-8,
+	0.66230952777759912131e-9,
+	0.10221617853845219559e-8,
+	0.11021342275913702730e-9,
+	-0.11218590723482488022e-9,
+	-0.26436234102036148727e-10,
+	0.13983989822422614371e-10,
+	0.44241358666886828416e-11,
+	-0.21499998654733935499e-11,
+	-0.66298528366150004155e-12,
+	0.39662692812596625793e-12,
+	0.84174358579189563361e-13,
+	-0.80224111383279409885e-13,
+	-0.53478959416896281839e-14,
+	0.16139717253579235683e-13,
+	-0.17396499491646346695e-14,
+	-0.29120354446421262669e-14,
+	0.98078439992308637384e-15,
+	0.38551721794845169976e-15,
+	-0.30803894128877651226e-15,
+	-0.18557633421031550184e-17,
+	0.69514126332211254420e-16,
+	-0.20598206746866504434e-16,
+	-0.95761205217609344822e-17,
+	0.80543107226718526062e-17,
+	-0.51003422367838324694e-18,
+	-0.17755274502293981760e-17,
+	0.79448349494871493843e-18,
+	0.13494749083482199012e-18,
+	-0.25608987681103828150e-18,
+	0.71715006141681369235e-19,
+	0.36018186604178227917e-19,
+	-0.34745050321923272039e-19,
+}
+
+var arbidp1 = []float64{
+	0.18021585400746818468e1,
+	-0.67653437845638278842e-1,
+	0.14740452629307378875e-1,
+	0.16231651968095228882e-2,
+	-0.16942538610610863414e-2,
+	0.46706234662189094632e-3,
+	0.14995291773701008517e-5,
+	-0.67423366696176167070e-4,
+	0.38687607627973557344e-4,
+	-0.12962333632796960211e-4,
+	0.18936789564938916315e-5,
+	0.98035752207175424395e-6,
+	-0.10265998064651986385e-5,
+	0.55966715259874639870e-6,
+	-0.22078090177844683174e-6,
+	0.59766934645161510563e-7,
+	-0.32183880258444421805e-8,
+	-0.91483908012166386400e-8,
+	0.78340819635242692268e-8,
+	-0.44193981939650484348e-8,
+	0.19855047190774193174e-8,
+	-0.72007879894153652932e-9,
+	0.18614889423576567973e-9,
+	-0.63893896267523148517e-11,
+	-0.32942749997462768655e-10,
+	0.28853109393480287281e-10,
+	-0.17421592117382695229e-10,
+	0.87131804807552338611e-11,
+	-0.37570367013053949846e-11,
+	0.13769330544604624275e-11,
+	-0.38875884207972490037e-12,
+	0.43649713261133897680e-13,
+	0.44615327180666056339e-13,
+	-0.47895365543333743721e-13,
+	0.32113491554710823332e-13,
+	-0.17766686008918899847e-13,
+	0.86607786339169064410e-14,
+	-0.37829119414842174935e-14,
+	0.14642640222326619872e-14,
+	-0.47599961308662941751e-15,
+	0.10474505628885662358e-15,
+	0.10018993102914562265e-16,
+	-0.31489446823120726993e-16,
+	0.25779446137477825702e-16,
+	-0.16101912592016687180e-16,
+	0.87516186776163884100e-17,
+	-0.43119380437945497292e-17,
+	0.19507021687542483881e-17,
+	-0.80739209517711637364e-18,
+	0.29864400212112336209e-18,
+	-0.91953719353280009541e-19,
+}
+
+var arbidp2 = []float64{
+	0.19803679084942883866e1,
+	-0.10075400940078878778e-1,
+	-0.27727561046485439521e-3,
+	-0.20148142341294080638e-4,
+	-0.25428394138216132587e-5,
+	-0.32078811294877738471e-6,
+	0.16416065993045199680e-7,
+	0.30309058170972902657e-7,
+	0.84492549562186520559e-8,
+	-0.62381552317659956642e-9,
+	-0.10438089612638519681e-8,
+	-0.11837332348108179061e-9,
+	0.11335843993261235693e-9,
+	0.27553316693462308429e-10,
+	-0.14044871136433164617e-10,
+	-0.45787134364534322119e-11,
+	0.21562795793366774795e-11,
+	0.68606738411404192215e-12,
+	-0.39857250355836806841e-12,
+	-0.87798085255942256123e-13,
+	0.80890922743181197783e-13,
+	0.59022414460586581333e-14,
+	-0.16337596664523529036e-13,
+	0.16688835349791288316e-14,
+	0.29627266705211560365e-14,
+	-0.97722947019838989555e-15,
+	-0.39647402618366854443e-15,
+	0.30994810054021304293e-15,
+	0.36604480546005826862e-17,
+	-0.70420655290145206425e-16,
+	0.20468951738935113156e-16,
+	0.98177291788193846282e-17,
+	-0.81032057437441204753e-17,
+	0.47088979720653744731e-18,
+	0.18007980139457474125e-17,
+	-0.79443563970730572462e-18,
+	-0.14107225467667610230e-18,
+	0.25848785658999319557e-18,
+	-0.71166288959338936197e-19,
+	-0.36852026772122306160e-19,
+	0.34963964368724065703e-19,
+}
+
+var armodm1 = []float64{
+	0.19676668410844714266e1,
+	-0.16319263663853539755e-1,
+	-0.83297006909931725072e-3,
+	0.37926867950321922442e-3,
+	-0.85420557835439854633e-4,
+	0.15205733545983108314e-4,
+	-0.19411178006993059477e-5,
+	-0.26420032184666674906e-8,
+	0.12030480321312687503e-6,
+	-0.57601586293700553292e-7,
+	0.20149131437025288538e-7,
+	-0.60180471451999036795e-8,
+	0.15797549107218937084e-8,
+	-0.35379989471904545526e-9,
+	0.57945503179313037600e-10,
+	-0.32351198815556382928e-12,
+	-0.54806368125616526340e-11,
+	0.33531393624285547821e-11,
+	-0.14959518655024146249e-11,
+	0.57797675048196000410e-12,
+	-0.20317287052700289165e-12,
+	0.66003373912054147714e-13,
+	-0.19769079662594437093e-13,
+	0.53369265659854013082e-14,
+	-0.12114262573531946847e-14,
+	0.17273187222375183125e-15,
+	0.30217393425094160438e-16,
+	-0.41700609137575285515e-16,
+	0.24769738918472926610e-16,
+	-0.11818448530229432921e-16,
+	0.50444413697482588864e-17,
+	-0.20002063426084093496e-17,
+	0.74865124755181763585e-18,
+	-0.26605500833773018007e-18,
+	0.89677167053885544529e-19,
+	-0.28421368725023465356e-19,
+}
+
+var armodm2 = []float64{
+	0.19991173823903476992e1,
+	-0.58424090395284501539e-3,
+	-0.13993049407352323997e-3,
+	0.33168853777819617726e-5,
+	0.28245963697804511666e-6,
+	-0.32943493633386557808e-7,
+	0.25336014707100300078e-9,
+	0.33435112250964407350e-9,
+	-0.42710115140654948425e-10,
+	0.11572747926946769406e-12,
+	0.85513956568369113052e-12,
+	-0.15323718139274074347e-12,
+	0.67063561701436824766e-14,
+	0.32077049424648251531e-14,
+	-0.95749198003859335126e-15,
+	0.11711734425845462698e-15,
+	0.75091592025609283885e-17,
+	-0.70748054124661161954e-17,
+	0.17050752508551943985e-17,
+	-0.17240204044719606630e-18,
+	-0.33502868580285541032e-19,
+	0.20438338711850194086e-19,
+}
+
+var arthm1 = []float64{
+	0.91748781423914207906e-1,
+	0.29888689116246724007e-1,
+	-0.29433898503978917253e-2,
+	0.25105997881059152225e-3,
+	0.16618234387032026301e-5,
+	-0.96188334225055846800e-5,
+	0.36454575053587617882e-5,
+	-0.10242189415270721775e-5,
+	0.24162965073924828606e-6,
+	-0.46660842495802518457e-7,
+	0.57086658443815146641e-8,
+	0.68757372793905267480e-9,
+	-0.84900285863599133137e-9,
+	0.41300034598194870873e-9,
+	-0.15838867115779302238e-9,
+	0.53322855943907983692e-10,
+	-0.16221649387101491757e-10,
+	0.44431472367435523383e-11,
+	-0.10517280885291800791e-11,
+	0.18419066516498823208e-12,
+	-0.20977049874891303653e-14,
+	-0.19141197391107670409e-13,
+	0.12649630822269477493e-13,
+	-0.60791729866867321520e-14,
+	0.25376309781465016322e-14,
+	-0.96964500976024123901e-15,
+	0.34598680051865890528e-15,
+	-0.11593758296010484563e-15,
+	0.36307211980408682096e-16,
+	-0.10426838386639539030e-16,
+	0.26107842880877838480e-17,
+	-0.48065214851346794455e-18,
+	-0.19080301365206086650e-20,
+	0.64442319811675018211e-19,
+	-0.45494911295223350038e-19,
+	0.23848010681572785498e-19,
+	-0.10966325050069741894e-19,
+}
+
+var arthm2 = []float64{
+	0.12885015108647883466e-1,
+	0.64093584333035173495e-2,
+	-0.39072681423161402027e-4,
+	-0.56235150587865463302e-5,
+	0.31547449227117729661e-6,
+	0.11845785102443099234e-7,
+	-0.34144614801041479994e-8,
+	0.20063326558893839817e-9,
+	0.24435177552183374264e-10,
+	-0.68185265788377722730e-11,
+	0.57038436106831128578e-12,
+	0.61489672991928363615e-13,
+	-0.27341681137824639790e-13,
+	0.39326928073511695973e-14,
+	0.14626089231891406128e-16,
+	-0.14745215746185256584e-15,
+	0.37808065502280383783e-16,
+	-0.41007125862632138310e-17,
+	-0.54360113856218706604e-18,
+	0.36484697491017012181e-18,
+	-0.87438458577537192048e-19,
+}
+
+var armodn1 = []float64{
+	0.20483225184791767586e1,
+	0.24821634136999469790e-1,
+	0.15974741082361022078e-2,
+	-0.56549917283607588555e-3,
+	0.11440370689259728651e-3,
+	-0.18327187016556824360e-4,
+	0.19319674307189398147e-5,
+	0.14098907149970454721e-6,
+	-0.17535043294166317670e-6,
+	0.73528163563043670082e-7,
+	-0.24016777759007129943e-7,
+	0.68063697533880138649e-8,
+	-0.16969457410168586605e-8,
+	0.35478099216609447425e-9,
+	-0.49172688999169382521e-10,
+	-0.45469336725435964472e-11,
+	0.74457254706073577597e-11,
+	-0.40390688403471721038e-11,
+	0.17132749403804830038e-11,
+	-0.64121367678819363465e-12,
+	0.21988253998949969629e-12,
+	-0.69827601270399511752e-13,
+	0.20406656569176011187e-13,
+	-0.53304616198982721941e-14,
+	0.11375242784305358974e-14,
+	-0.12562297333832145343e-15,
+	-0.52622989554972342678e-16,
+	0.51046205834495922342e-16,
+	-0.28362742045908536113e-16,
+	0.13117298655938035806e-16,
+	-0.54891211987474966273e-17,
+	0.21442461022555475064e-17,
+	-0.79236159050641245930e-18,
+	0.27817134957961375422e-18,
+	-0.92549250399489276961e-19,
+	0.28873779644535601476e-19,
+}
+
+var armodn2 = []float64{
+	0.20012414083834282440e1,
+	0.82247812496719874508e-3,
+	0.19807427309572090696e-3,
+	-0.40969740919090609901e-5,
+	-0.35911008497774597966e-6,
+	0.38468459495246650549e-7,
+	-0.16435781201204583432e-9,
+	-0.38358116810129347870e-9,
+	0.46578133753311357462e-10,
+	0.80778689526185162526e-13,
+	-0.94529438262087955506e-12,
+	0.16363098636831001465e-12,
+	-0.65016538786839395957e-14,
+	-0.35160482974014910606e-14,
+	0.10169483933375712592e-14,
+	-0.12079016119785229796e-15,
+	-0.87109135527941981238e-17,
+	0.75283376640287832735e-17,
+	-0.17798277634910207562e-17,
+	0.17383232986710637694e-18,
+	0.36612259236745881424e-19,
+	-0.21491003671724342402e-19,
+}
+
+var arphn1 = []float64{
+	-0.12813812572626935481e0,
+	-0.41499732734528368032e-1,
+	0.43245220841910094991e-2,
+	-0.33526894178859248056e-3,
+	-0.12693647533348484284e-4,
+	0.15336781971157030644e-4,
+	-0.51122727966695168308e-5,
+	0.13078805626397044906e-5,
+	-0.28074268246535911346e-6,
+	0.47507834698361523287e-7,
+	-0.37216715792167518260e-8,
+	-0.16764355175296479612e-8,
+	0.11947482190353675724e-8,
+	-0.51550054275604580720e-9,
+	0.18522616023399289790e-9,
+	-0.59445721408038369806e-10,
+	0.17329009617697635473e-10,
+	-0.45296708221959721780e-11,
+	0.10005649522142791752e-11,
+	-0.14589800854833629410e-12,
+	-0.15877114508643531305e-13,
+	0.26260933472995896718e-13,
+	-0.15206968765984239866e-13,
+	0.69337246728575808656e-14,
+	-0.28051789295992402350e-14,
+	0.10476709256531806301e-14,
+	-0.36669169978232161694e-15,
+	0.12061669118175792046e-15,
+	-0.37000629289934933164e-16,
+	0.10336820058577602448e-16,
+	-0.24663802443150143631e-17,
+	0.39321465049821690513e-18,
+	0.44069945745770335671e-19,
+	-0.82630609399387084617e-19,
+	0.52802787997210301181e-19,
+	-0.26631026135815948685e-19,
+	0.11978515151124559505e-19,
+}
+
+var arphn2 = []float64{
+	-0.18048118054392429064e-1,
+	-0.89797741013158024958e-2,
+	0.52283045054563257275e-4,
+	0.76312053823937915435e-5,
+	-0.38700337949426061764e-6,
+	-0.15898331548949654215e-7,
+	0.40135045712295194533e-8,
+	-0.21489597384437557082e-9,
+	-0.29111561123019154298e-10,
+	0.75430402867881484105e-11,
+	-0.59473141025519179058e-12,
+	-0.71464134928964040740e-13,
+	0.29631317942686132177e-13,
+	-0.41225413577937529584e-14,
+	-0.40146954218799565839e-16,
+	0.15932701616694859285e-15,
+	-0.39814171078136935584e-16,
+	0.41684152957148151482e-17,
+	0.60772427512871161987e-18,
+	-0.38634103724593561367e-18,
+	0.90905748791695248547e-19,
+}
@@ -0,0 +1,780 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+//
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+//
+// The Bessel functions of order 0 and 1 for real arguments follow the
+// scheme of the Chebyshev expansions in
+//   ALGORITHM 757, COLLECTED ALGORITHMS FROM ACM.
+//   THIS WORK PUBLISHED IN TRANSACTIONS ON MATHEMATICAL SOFTWARE,
+//   VOL. 22, NO. 3, September, 1996, P.  288--301.
+//   Allan MacLeod, Dept. of Mathematics and Statistics, University of Paisley
+// and of the SLATEC routines BESJ0, BESY0, BESI0E and BESK0E by W. Fullerton.
+// The coefficients were computed to 20 decimal places from the power series
+// and the Hankel asymptotic expansions.
+
+package toms
+
+import (
+	"github.com/dreading/gospecfunc/utils"
+	"math"
+)
+
+const (
+	piby4  = 0.78539816339744830962e0
+	twobpi = 0.63661977236758134308e0
+)
+
+// BESJ0 calculates the Bessel function J0(x)
+// For |x| <= 4 the code uses a Chebyshev expansion in x^2, and for |x| > 4
+// the modulus and phase J0(x) = M0(x) cos(θ0(x)).
+func BESJ0(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	if X <= 4 {
+		return utils.Cheval(len(arbj0)-1, arbj0, X*X/8-1)
+	}
+	if math.IsInf(X, 1) {
+		return 0
+	}
+	M, PSI := besmp(X, armod01, arth01, armod02, arth02)
+	S, C := math.Sincos(X)
+	SP, CP := math.Sincos(PSI - piby4)
+	return M * (C*CP - S*SP)
+}
+
+// BESJ1 calculates the Bessel function J1(x)
+// For |x| <= 4 the code uses a Chebyshev expansion in x^2, and for |x| > 4
+// the modulus and phase J1(x) = M1(x) cos(θ1(x)).
+func BESJ1(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	var RET float64
+	if X <= 4 {
+		RET = X * utils.Cheval(len(arbj1)-1, arbj1, X*X/8-1)
+	} else if math.IsInf(X, 1) {
+		RET = 0
+	} else {
+		M, PSI := besmp(X, armod11, arth11, armod12, arth12)
+		S, C := math.Sincos(X)
+		SP, CP := math.Sincos(PSI - 3*piby4)
+		RET = M * (C*CP - S*SP)
+	}
+	if XVALUE < 0 {
+		RET = -RET
+	}
+	return RET
+}
+
+// BESY0 calculates the Bessel function Y0(x) for x >= 0
+// For x <= 4 the code uses
+//    Y0(x) = (2/π) ln(x/2) J0(x) + f(x^2)
+// with a Chebyshev expansion for f, and for x > 4 the modulus and
+// phase Y0(x) = M0(x) sin(θ0(x)).
+func BESY0(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X <= 4 {
+		return utils.Cheval(len(arby0)-1, arby0, X*X/8-1) + twobpi*math.Log(X/2)*BESJ0(X)
+	}
+	if math.IsInf(X, 1) {
+		return 0
+	}
+	M, PSI := besmp(X, armod01, arth01, armod02, arth02)
+	S, C := math.Sincos(X)
+	SP, CP := math.Sincos(PSI - piby4)
+	return M * (S*CP + C*SP)
+}
+
+// BESY1 calculates the Bessel function Y1(x) for x >= 0
+// For x <= 4 the code uses
+//    Y1(x) = (2/π) ln(x/2) J1(x) - 2/(π x) + x f(x^2)
+// with a Chebyshev expansion for f, and for x > 4 the modulus and
+// phase Y1(x) = M1(x) sin(θ1(x)).
+func BESY1(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X == 0 {
+		return math.Inf(-1)
+	}
+	if X <= 4 {
+		return X*utils.Cheval(len(arby1)-1, arby1, X*X/8-1) + twobpi*(math.Log(X/2)*BESJ1(X)-1/X)
+	}
+	if math.IsInf(X, 1) {
+		return 0
+	}
+	M, PSI := besmp(X, armod11, arth11, armod12, arth12)
+	S, C := math.Sincos(X)
+	SP, CP := math.Sincos(PSI - 3*piby4)
+	return M * (S*CP + C*SP)
+}
+
+// besmp returns the modulus M(x) and the phase correction ψ(x) = θ(x) - x + (2ν+1)π/4
+// of the Bessel functions of order ν = 0 or 1 for x > 4. The expansions are
+// √x M(x) and x ψ(x) in Chebyshev series of 1/x^2 on (4,8] and (8,∞).
+func besmp(X float64, MOD1, TH1, MOD2, TH2 []float64) (float64, float64) {
+	var T, A, P float64
+	if X <= 8 {
+		T = (128/(X*X) - 5) / 3
+		A = utils.Cheval(len(MOD1)-1, MOD1, T)
+		P = utils.Cheval(len(TH1)-1, TH1, T)
+	} else {
+		T = 128/(X*X) - 1
+		A = utils.Cheval(len(MOD2)-1, MOD2, T)
+		P = utils.Cheval(len(TH2)-1, TH2, T)
+	}
+	return A / math.Sqrt(X), P / X
+}
+
+// BESI0 calculates the modified Bessel function I0(x)
+func BESI0(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	if X <= 3 {
+		return utils.Cheval(len(arbi0)-1, arbi0, X*X/4.5-1)
+	}
+	if math.IsInf(X, 1) {
+		return X
+	}
+	// split the exponential so that e^x does not overflow before I0(x)
+	E := math.Exp(X / 2)
+	return (besi01e(X, arai01, arai02) * E) * E
+}
+
+// BESI0E calculates the exponentially scaled modified Bessel function exp(-|x|) I0(x)
+func BESI0E(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	if X <= 3 {
+		return math.Exp(-X) * utils.Cheval(len(arbi0)-1, arbi0, X*X/4.5-1)
+	}
+	return besi01e(X, arai01, arai02)
+}
+
+// BESI1 calculates the modified Bessel function I1(x)
+func BESI1(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	var RET float64
+	if X <= 3 {
+		RET = X * utils.Cheval(len(arbi1)-1, arbi1, X*X/4.5-1)
+	} else if math.IsInf(X, 1) {
+		RET = X
+	} else {
+		E := math.Exp(X / 2)
+		RET = (besi01e(X, arai11, arai12) * E) * E
+	}
+	if XVALUE < 0 {
+		RET = -RET
+	}
+	return RET
+}
+
+// BESI1E calculates the exponentially scaled modified Bessel function exp(-|x|) I1(x)
+func BESI1E(XVALUE float64) float64 {
+	X := math.Abs(XVALUE)
+	var RET float64
+	if X <= 3 {
+		RET = math.Exp(-X) * X * utils.Cheval(len(arbi1)-1, arbi1, X*X/4.5-1)
+	} else {
+		RET = besi01e(X, arai11, arai12)
+	}
+	if XVALUE < 0 {
+		RET = -RET
+	}
+	return RET
+}
+
+// besi01e computes exp(-x) I(x) for x > 3 from the Chebyshev
+// expansions of √x exp(-x) I(x) in 1/x on (3,8] and (8,∞).
+func besi01e(X float64, A1, A2 []float64) float64 {
+	if X <= 8 {
+		return utils.Cheval(len(A1)-1, A1, (48/X-11)/5) / math.Sqrt(X)
+	}
+	return utils.Cheval(len(A2)-1, A2, 16/X-1) / math.Sqrt(X)
+}
+
+// BESK0 calculates the modified Bessel function K0(x) for x >= 0
+func BESK0(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X <= 2 {
+		return besk0small(X)
+	}
+	return besk01e(X, arak01, arak02) * math.Exp(-X)
+}
+
+// BESK0E calculates the exponentially scaled modified Bessel function exp(x) K0(x) for x >= 0
+func BESK0E(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X <= 2 {
+		return math.Exp(X) * besk0small(X)
+	}
+	return besk01e(X, arak01, arak02)
+}
+
+// BESK1 calculates the modified Bessel function K1(x) for x >= 0
+func BESK1(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X <= 2 {
+		return besk1small(X)
+	}
+	return besk01e(X, arak11, arak12) * math.Exp(-X)
+}
+
+// BESK1E calculates the exponentially scaled modified Bessel function exp(x) K1(x) for x >= 0
+func BESK1E(X float64) float64 {
+	if X < 0 {
+		return math.NaN()
+	}
+	if X <= 2 {
+		return math.Exp(X) * besk1small(X)
+	}
+	return besk01e(X, arak11, arak12)
+}
+
+// besk0small computes K0(x) = f(x^2) - ln(x/2) I0(x) for 0 <= x <= 2
+func besk0small(X float64) float64 {
+	if X == 0 {
+		return math.Inf(1)
+	}
+	return utils.Cheval(len(arbk0)-1, arbk0, X*X/2-1) - math.Log(X/2)*BESI0(X)
+}
+
+// besk1small computes K1(x) = (f(x^2) + x ln(x/2) I1(x)) / x for 0 <= x <= 2
+func besk1small(X float64) float64 {
+	if X == 0 {
+		return math.Inf(1)
+	}
+	return (utils.Cheval(len(arbk1)-1, arbk1, X*X/2-1) + X*math.Log(X/2)*BESI1(X)) / X
+}
+
+// besk01e computes exp(x) K(x) for x > 2 from the Chebyshev
+// expansions of √x exp(x) K(x) in 1/x on (2,8] and (8,∞).
+func besk01e(X float64, A1, A2 []float64) float64 {
+	if X <= 8 {
+		return utils.Cheval(len(A1)-1, A1, (16/X-5)/3) / math.Sqrt(X)
+	}
+	return utils.Cheval(len(A2)-1, A2, 16/X-1) / math.Sqrt(X)
+}
+
+var arbj0 = []float64{
+	0.10025416196893913701e0,
+	-0.66522300776440513178e0,
+	0.24898370349828131370e0,
+	-0.33252723170035769654e-1,
+	0.23114179304694015463e-2,
+	-0.99112774199508092339e-4,
+	0.28916708643998808885e-5,
+	-0.61210858663032635058e-7,
+	0.98386507938567841325e-9,
+	-0.12423551597301765146e-10,
+	0.12654336302559045798e-12,
+	-0.10619456495287244547e-14,
+	0.74706210758024567437e-17,
+	-0.44697032274412780548e-19,
+}
+
+var arbj1 = []float64{
+	0.38273858486667213439e0,
+	-0.25361521830790639562e0,
+	0.50127080984469568505e-1,
+	-0.46315148096250819184e-2,
+	0.24799622941591402454e-3,
+	-0.86789486862788258452e-5,
+	0.21429391714379369150e-6,
+	-0.39360930791831797923e-8,
+	0.55911823179468800402e-10,
+	-0.63276164046613930248e-12,
+	0.58409916108572470033e-14,
+	-0.44825338187012581904e-16,
+	0.29053844926250246631e-18,
+}
+
+var arby0 = []float64{
+	0.73872216060713442678e0,
+	-0.12834523756042034605e0,
+	-0.10437884799794249366e0,
+	0.23662749183969695409e-1,
+	-0.20903916477004862392e-2,
+	0.10397545393905725210e-3,
+	-0.33697471624239720967e-5,
+	0.77293842676706671585e-7,
+	-0.13249767726642595914e-8,
+	0.17648232615404527921e-10,
+	-0.18810550715801962006e-12,
+	0.16418654853661495028e-14,
+	-0.11956594386046060857e-16,
+	0.73772962974401858425e-19,
+}
+
+var arby1 = []float64{
+	0.23097599242265496361e0,
+	0.57189009545005257949e-1,
+	-0.29677037154290367121e-1,
+	0.37900552385562700434e-2,
+	-0.24348464497343325692e-3,
+	0.96343568298703332572e-5,
+	-0.26054667751621903607e-6,
+	0.51402450578717709513e-8,
+	-0.77408016024644707986e-10,
+	0.92011765295339047388e-12,
+	-0.88593263466147829785e-14,
+	0.70538976792082365514e-16,
+	-0.47235018833781286479e-18,
+}
+
+var armod01 = []float64{
+	0.15921165624682774271e1,
+	-0.10505909972719051025e-2,
+	0.14701598407687597541e-4,
+	-0.50585576060385542233e-6,
+	0.27872545386324441766e-7,
+	-0.20623636117809148026e-8,
+	0.18702143131388796751e-9,
+	-0.19693309711356362002e-10,
+	0.23259737939992754440e-11,
+	-0.30095203449382502729e-12,
+	0.41945213338506691815e-13,
+	-0.62194493121884458260e-14,
+	0.97182604113360684696e-15,
+	-0.15884785857010752074e-15,
+	0.27000721936713088901e-16,
+	-0.47500923652340089925e-17,
+	0.86151281626043708732e-18,
+	-0.16056086869561448157e-18,
+	0.30665139873144829752e-19,
+}
+
+var arth01 = []float64{
+	-0.24548295213424597462e0,
+	0.12544121039084615781e-2,
+	-0.31253950414871522855e-4,
+	0.14709778249940831164e-5,
+	-0.99543488937950033643e-7,
+	0.85493166733203041248e-8,
+	-0.86989759526554334558e-9,
+	0.10052099533559791085e-9,
+	-0.12828230601708892903e-10,
+	0.17731700781805131706e-11,
+	-0.26174574569485577489e-12,
+	0.40828351389972059622e-13,
+	-0.66751668239742720055e-14,
+	0.11365761393071629448e-14,
+	-0.20051189620647160251e-15,
+	0.36497978794766269636e-16,
+	-0.68309637564582303169e-17,
+	0.13107583145670756620e-17,
+	-0.25723363101850607779e-18,
+	0.51521657441863959925e-19,
+	-0.10513017563758802638e-19,
+}
+
+var armod02 = []float64{
+	0.15950041514522838137e1,
+	-0.38018646823656709917e-3,
+	0.22583393010314811930e-5,
+	-0.38957258023722287647e-7,
+	0.12468864165120816979e-8,
+	-0.60659490221025037798e-10,
+	0.40084616514217469909e-11,
+	-0.33509981833980942177e-12,
+	0.33771197165174173619e-13,
+	-0.39645859016350126825e-14,
+	0.52861115038838574169e-15,
+	-0.78525190834508576245e-16,
+	0.12803005733866895400e-16,
+	-0.22639962963915056621e-17,
+	0.43004969296573902983e-18,
+	-0.87057498051355429132e-19,
+	0.18658627139612914959e-19,
+}
+
+var arth02 = []float64{
+	-0.24901780862128936718e0,
+	0.48550299609623749241e-3,
+	-0.54511837345017204951e-5,
+	0.13558673059405964054e-6,
+	-0.55691398902227626228e-8,
+	0.32609031824994335304e-9,
+	-0.24918807862461341122e-10,
+	0.23449377420882520525e-11,
+	-0.26096534444310387553e-12,
+	0.33353140420097394377e-13,
+	-0.47890000440572692714e-14,
+	0.75956178436192430693e-15,
+	-0.13131556016891737111e-15,
+	0.24483618345243925734e-16,
+	-0.48805729810643034044e-17,
+	0.10327285029798268450e-17,
+	-0.23057633815024739350e-18,
+	0.54044443000126681341e-19,
+	-0.13240695191587371331e-19,
+}
+
+var armod11 = []float64{
+	0.16069845452618063015e1,
+	0.32749150397159649007e-2,
+	-0.29877832668316985920e-4,
+	0.83312371779919745314e-6,
+	-0.41126656903020073049e-7,
+	0.28553442287892152207e-8,
+	-0.24854083054156238781e-9,
+	0.25433933380725824427e-10,
+	-0.29410457728229675235e-11,
+	0.37433920254939033093e-12,
+	-0.51491182938211672187e-13,
+	0.75525359498651439080e-14,
+	-0.11694097068288464442e-14,
+	0.18965624494347915717e-15,
+	-0.32019553686932864207e-16,
+	0.55995483993162041145e-17,
+	-0.10102158947304324431e-17,
+	0.18738449857275629833e-18,
+	-0.35635374703285802193e-19,
+}
+
+var arth11 = []float64{
+	0.73823860128742974663e0,
+	-0.33361113174483906384e-2,
+	0.61463454888046964699e-4,
+	-0.24024585161602374265e-5,
+	0.14663555577509746153e-6,
+	-0.11841917305589180567e-7,
+	0.11574198963919197052e-8,
+	-0.13001161129439187449e-9,
+	0.16245391141361731938e-10,
+	-0.22089636821403188752e-11,
+	0.32180304258553177090e-12,
+	-0.49653147932768480786e-13,
+	0.80438900432847825986e-14,
+	-0.13589121310161291385e-14,
+	0.23810504397147214870e-15,
+	-0.43081466363849106724e-16,
+	0.80202544032771002435e-17,
+	-0.15316310642462311864e-17,
+	0.29928606352715568924e-18,
+	-0.59709964658085443394e-19,
+	0.12140289669415185024e-19,
+}
+
+var armod12 = []float64{
+	0.15980797915623305003e1,
+	0.11509611895046853062e-2,
+	-0.43124821643382054099e-5,
+	0.59518396100888163078e-7,
+	-0.17048440198269098574e-8,
+	0.77982654136111095087e-10,
+	-0.49589861267664158096e-11,
+	0.40384324164211415176e-12,
+	-0.39930461637251754511e-13,
+	0.46198861831189665130e-14,
+	-0.60892080190953830939e-15,
+	0.89609309164338709688e-16,
+	-0.14496294239420155068e-16,
+	0.25464631585376973272e-17,
+	-0.48094728746472143086e-18,
+	0.96876846682895372671e-19,
+	-0.20672133722788060647e-19,
+}
+
+var arth12 = []float64{
+	0.74749957203587276055e0,
+	-0.12400777144651711253e-2,
+	0.99252442404424527377e-5,
+	-0.20303690737159711052e-6,
+	0.75359617705690885712e-8,
+	-0.41661612715343550107e-9,
+	0.30701618070834890478e-10,
+	-0.28178499637605213963e-11,
+	0.30790696739040295266e-12,
+	-0.38803300262803433378e-13,
+	0.55096039608630913058e-14,
+	-0.86590060768383996154e-15,
+	0.14856049141537047797e-15,
+	-0.27519529815907175408e-16,
+	0.54550796090505514998e-17,
+	-0.11486534501995678492e-17,
+	0.25535213377941199119e-18,
+	-0.59621490195635170390e-19,
+	0.14556622899574189354e-19,
+}
+
+var arbi0 = []float64{
+	0.54233945274716085505e1,
+	0.19273379539938082700e1,
+	0.22826445869203013389e0,
+	0.13048914667072904281e-1,
+	0.43442709008164874514e-3,
+	0.94226576860019346639e-5,
+	0.14340062895106910800e-6,
+	0.16138490696617490699e-8,
+	0.13966500445356696995e-10,
+	0.95794517255054453446e-13,
+	0.53339818598625021310e-15,
+	0.24587160884374707747e-17,
+}
+
+var arbi1 = []float64{
+	0.17480282867389001403e1,
+	0.40734887667546480608e0,
+	0.34838994299959455866e-1,
+	0.15453945563001236039e-2,
+	0.41888521098377784129e-4,
+	0.76490267648362114742e-6,
+	0.10042493924741178689e-7,
+	0.99322077919238106481e-10,
+	0.76638017918447637275e-12,
+	0.47414189238167394980e-14,
+	0.24041144040745181800e-16,
+	0.10171505007093713649e-18,
+}
+
+var arai01 = []float64{
+	0.82575994494023795943e0,
+	0.75913808108233455073e-2,
+	0.41531313389237505019e-3,
+	0.10700764634390730736e-4,
+	-0.79011799792128946608e-5,
+	-0.78261435014387522698e-6,
+	0.27838499429488708064e-6,
+	0.82524726006120271920e-8,
+	-0.12044639455201991791e-7,
+	0.15596485985060764436e-8,
+	0.22925563671033165435e-9,
+	-0.11916228842790646037e-9,
+	0.17578549160324098302e-10,
+	0.11282244632189005171e-11,
+	-0.11468486259272988777e-11,
+	0.27155920548036628726e-12,
+	-0.24158746665626878384e-13,
+	-0.60844698882551250646e-14,
+	0.31457050771754772937e-14,
+	-0.71722129248711877180e-15,
+	0.78744934034541033961e-16,
+	0.10048027530094624023e-16,
+	-0.75668953653505348534e-17,
+	0.21503801068761198878e-17,
+	-0.37548583418308744292e-18,
+	0.23540658422269925769e-19,
+	0.11146676120479285302e-19,
+}
+
+var arai11 = []float64{
+	0.72153255818118521326e0,
+	-0.19229532314432206510e-1,
+	-0.61151858579437889823e-3,
+	-0.20699712533502277089e-4,
+	0.85856191458107255655e-5,
+	0.10494982467115908625e-5,
+	-0.29183389184479022021e-6,
+	-0.15593781466317390002e-7,
+	0.13180123671449447055e-7,
+	-0.14484234181830783176e-8,
+	-0.29085122439931420948e-9,
+	0.12663889178753823873e-9,
+	-0.16649477729192206706e-10,
+	-0.16666536446094329761e-11,
+	0.12426024142907682652e-11,
+	-0.27315493796724323973e-12,
+	0.20239478816458037807e-13,
+	0.73079500181168836362e-14,
+	-0.33329056344046749438e-14,
+	0.71753465585129537435e-15,
+	-0.69825303247962563559e-16,
+	-0.12999442015627607601e-16,
+	0.81209428642427988921e-17,
+	-0.21940162074107368982e-17,
+	0.36305161700296548483e-18,
+	-0.16951397724391041663e-19,
+	-0.12881848298979078071e-19,
+}
+
+var arai02 = []float64{
+	0.80449041101410883161e0,
+	0.33691164782556940899e-2,
+	0.68897583469168239843e-4,
+	0.28913705208347564830e-5,
+	0.20489185894690637418e-6,
+	0.22666689904981780646e-7,
+	0.33962320257083863452e-8,
+	0.49406023882249695891e-9,
+	0.11889147107846438342e-10,
+	-0.31499165279632413645e-10,
+	-0.13215811840447713119e-10,
+	-0.17941785315068061178e-11,
+	0.71801244513836662337e-12,
+	0.38527783827421427011e-12,
+	0.15400862175214098269e-13,
+	-0.41505693472872220866e-13,
+	-0.95548466988283076487e-14,
+	0.38116806693526224207e-14,
+	0.17725601330565263836e-14,
+	-0.34254856196772191346e-15,
+	-0.28276239805165834849e-15,
+	0.34612228676974610931e-16,
+	0.44656214202967599990e-16,
+	-0.48305044859441820713e-17,
+	-0.72331804878747539546e-17,
+	0.99214754121736985988e-18,
+	0.11936508908459820855e-17,
+	-0.24887098371508072358e-18,
+	-0.19384264541609059294e-18,
+	0.64446566973734438786e-19,
+	0.28860515962892243216e-19,
+	-0.16019549071749718280e-19,
+}
+
+var arai12 = []float64{
+	0.77857623501828012047e0,
+	-0.97610974913614684078e-2,
+	-0.11058893876262371629e-3,
+	-0.38825648088776903935e-5,
+	-0.25122362378702089253e-6,
+	-0.26314688468895195068e-7,
+	-0.38353803859642370220e-8,
+	-0.55897434621965838069e-9,
+	-0.18974958123505412345e-10,
+	0.32526035830154882386e-10,
+	0.14125807436613781332e-10,
+	0.20356285441470895072e-11,
+	-0.71985517762459085121e-12,
+	-0.40835511110921973182e-12,
+	-0.21015418427726643130e-13,
+	0.42724400167119513543e-13,
+	0.10420276984128802764e-13,
+	-0.38144030724370078048e-14,
+	-0.18803547755107824485e-14,
+	0.33082023109209282827e-15,
+	0.29626289976459501391e-15,
+	-0.32095259219934239588e-16,
+	-0.46503053684893583256e-16,
+	0.44143483230717079499e-17,
+	0.75172963108421048054e-17,
+	-0.93141788673268833756e-18,
+	-0.12421932751948909561e-17,
+	0.24142767194548484690e-18,
+	0.20269443840532851794e-18,
+	-0.63942671882690977970e-19,
+	-0.30498124523730958908e-19,
+	0.16128418516514802459e-19,
+}
+
+var arbk0 = []float64{
+	-0.53532739323390276872e0,
+	0.34428989992462848689e0,
+	0.35979936515361501627e-1,
+	0.12646154114469259234e-2,
+	0.22862121031194517861e-4,
+	0.25347910790261494573e-6,
+	0.19045163772202088590e-8,
+	0.10349695257633624585e-10,
+	0.42598161427910825765e-13,
+	0.13744654358807508969e-15,
+	0.35708965285083735910e-18,
+}
+
+var arbk1 = []float64{
+	0.15253002273389477705e1,
+	-0.35315596077654487567e0,
+	-0.12261118082265714823e0,
+	-0.69757238596398643502e-2,
+	-0.17302889575130520630e-3,
+	-0.24334061415659682350e-5,
+	-0.22133876307347258558e-7,
+	-0.14114883926335277611e-9,
+	-0.66669016941993290061e-12,
+	-0.24274498505193659339e-14,
+	-0.70238634793862875972e-17,
+	-0.16543275155100994675e-19,
+}
+
+var arak01 = []float64{
+	0.24235605209667205858e1,
+	-0.22356526056998190520e-1,
+	0.77341811546938582353e-3,
+	-0.42810066888860994645e-4,
+	0.30817001738629747436e-5,
+	-0.26393672220096649741e-6,
+	0.25637130364034692063e-7,
+	-0.27427055499002012639e-8,
+	0.31694296580974995921e-9,
+	-0.39023532869621841416e-10,
+	0.50680406981885754021e-11,
+	-0.68895747410078706795e-12,
+	0.97449784978259176914e-13,
+	-0.14273328418845485054e-13,
+	0.21564125710214630396e-14,
+	-0.33496542551495627722e-15,
+	0.53352602169529116922e-16,
+	-0.86936699808907538077e-17,
+	0.14464043478622122279e-17,
+	-0.24528898255001296818e-18,
+	0.42337545262321715643e-19,
+}
+
+var arak11 = []float64{
+	0.27744313406973882970e1,
+	0.75719899531993678171e-1,
+	-0.14410515564754061230e-2,
+	0.66501169551257479394e-4,
+	-0.43699847095201407661e-5,
+	0.35402774997630526799e-6,
+	-0.33111637792932920209e-7,
+	0.34459775819010534532e-8,
+	-0.38989323474754271049e-9,
+	0.47208197504658356401e-10,
+	-0.60478356628753562345e-11,
+	0.81284948748658747888e-12,
+	-0.11386945747147891429e-12,
+	0.16540358408462282326e-13,
+	-0.24809025677068848222e-14,
+	0.38292378907024096948e-15,
+	-0.60647341040012418188e-16,
+	0.98324256232648616039e-17,
+	-0.16284168738284380036e-17,
+	0.27501536496752623714e-18,
+	-0.47289666463953250841e-19,
+}
+
+var arak02 = []float64{
+	0.24879813017369240776e1,
+	-0.91748526910256953107e-2,
+	0.14445509317750058210e-3,
+	-0.40136141754357097287e-5,
+	0.15678318108523106726e-6,
+	-0.77701104385217377103e-8,
+	0.46111825761797178826e-9,
+	-0.31585929978605657707e-10,
+	0.24350180393650411241e-11,
+	-0.20743313873983478432e-12,
+	0.19257872805899169390e-13,
+	-0.19275548058389601603e-14,
+	0.20621980291978715697e-15,
+	-0.23416851175793454442e-16,
+	0.28059028106386975540e-17,
+	-0.35305076311100393641e-18,
+	0.46452954228754065500e-19,
+}
+
+var arak12 = []float64{
+	0.25637930834373900104e1,
+	0.28328878130497209358e-1,
+	-0.24753706739052503454e-3,
+	0.57719724516072488205e-5,
+	-0.20689392195365483027e-6,
+	0.97399834413818041803e-8,
+	-0.55853361403806249847e-9,
+	0.37329966340461852404e-10,
+	-0.28250519610232254413e-11,
+	0.23720190024841441183e-12,
+	-0.21766773879917538312e-13,
+	0.21579141616160365760e-14,
+	-0.22901969307183234268e-15,
+	0.25828857298233796637e-16,
+	-0.30767526412640484236e-17,
+	0.38514877212278882596e-18,
+	-0.50447948975808661857e-19,
+}
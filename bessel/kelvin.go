@@ -0,0 +1,174 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The Kelvin functions of real order ν are defined for x ≥ 0 by
+//    ber(ν,x) + i bei(ν,x) = J(ν, x exp(3πi/4))
+//    ker(ν,x) + i kei(ν,x) = exp(-νπi/2) K(ν, x exp(πi/4))
+// See DLMF 10.61. As x → ∞ ber and bei grow like exp(x/√2), while ker and kei decay
+// like exp(-x/√2), so the scaled functions remove these factors and remain finite.
+// The functions ker and kei are singular at x = 0 for ν ≠ 0 and return NaN there. Each
+// function is accurate relative to the magnitude of the pair, so for example bei(ν,x) near
+// one of its zeros has a small absolute rather than relative error.
+
+var (
+	expπi4  = complex(math.Sqrt2/2, math.Sqrt2/2)  // exp(πi/4)
+	exp3πi4 = complex(-math.Sqrt2/2, math.Sqrt2/2) // exp(3πi/4)
+)
+
+// Ber computes the Kelvin function ber(ν,x) for x ≥ 0
+func Ber(ν float64, x float64) float64 {
+	return real(berbei(ν, x, 1))
+}
+
+// Berx computes the exponentially scaled Kelvin function ber for x ≥ 0
+// Berx = exp(-x/√2) * ber(ν,x)
+func Berx(ν float64, x float64) float64 {
+	return real(berbei(ν, x, 2))
+}
+
+// Bei computes the Kelvin function bei(ν,x) for x ≥ 0
+func Bei(ν float64, x float64) float64 {
+	return imag(berbei(ν, x, 1))
+}
+
+// Beix computes the exponentially scaled Kelvin function bei for x ≥ 0
+// Beix = exp(-x/√2) * bei(ν,x)
+func Beix(ν float64, x float64) float64 {
+	return imag(berbei(ν, x, 2))
+}
+
+// Ker computes the Kelvin function ker(ν,x) for x ≥ 0
+func Ker(ν float64, x float64) float64 {
+	return real(kerkei(ν, x, 1))
+}
+
+// Kerx computes the exponentially scaled Kelvin function ker for x ≥ 0
+// Kerx = exp(x/√2) * ker(ν,x)
+func Kerx(ν float64, x float64) float64 {
+	return real(kerkei(ν, x, 2))
+}
+
+// Kei computes the Kelvin function kei(ν,x) for x ≥ 0
+func Kei(ν float64, x float64) float64 {
+	return imag(kerkei(ν, x, 1))
+}
+
+// Keix computes the exponentially scaled Kelvin function kei for x ≥ 0
+// Keix = exp(x/√2) * kei(ν,x)
+func Keix(ν float64, x float64) float64 {
+	return imag(kerkei(ν, x, 2))
+}
+
+// Berd computes the derivative of the Kelvin function ber(ν,x) for x ≥ 0
+func Berd(ν float64, x float64) float64 {
+	return real(berbeid(ν, x, 1))
+}
+
+// Berdx computes the exponentially scaled derivative of the Kelvin function ber for x ≥ 0
+// Berdx = exp(-x/√2) * ber'(ν,x)
+func Berdx(ν float64, x float64) float64 {
+	return real(berbeid(ν, x, 2))
+}
+
+// Beid computes the derivative of the Kelvin function bei(ν,x) for x ≥ 0
+func Beid(ν float64, x float64) float64 {
+	return imag(berbeid(ν, x, 1))
+}
+
+// Beidx computes the exponentially scaled derivative of the Kelvin function bei for x ≥ 0
+// Beidx = exp(-x/√2) * bei'(ν,x)
+func Beidx(ν float64, x float64) float64 {
+	return imag(berbeid(ν, x, 2))
+}
+
+// Kerd computes the derivative of the Kelvin function ker(ν,x) for x ≥ 0
+func Kerd(ν float64, x float64) float64 {
+	return real(kerkeid(ν, x, 1))
+}
+
+// Kerdx computes the exponentially scaled derivative of the Kelvin function ker for x ≥ 0
+// Kerdx = exp(x/√2) * ker'(ν,x)
+func Kerdx(ν float64, x float64) float64 {
+	return real(kerkeid(ν, x, 2))
+}
+
+// Keid computes the derivative of the Kelvin function kei(ν,x) for x ≥ 0
+func Keid(ν float64, x float64) float64 {
+	return imag(kerkeid(ν, x, 1))
+}
+
+// Keidx computes the exponentially scaled derivative of the Kelvin function kei for x ≥ 0
+// Keidx = exp(x/√2) * kei'(ν,x)
+func Keidx(ν float64, x float64) float64 {
+	return imag(kerkeid(ν, x, 2))
+}
+
+// berbei computes ber(ν,x) + i bei(ν,x), scaled by exp(-x/√2) for KODE=2. The AMOS
+// scaling exp(-|Im(z)|) of J is exactly this factor.
+func berbei(ν float64, x float64, kode int) complex128 {
+	if x < 0 || math.IsNaN(x) {
+		return cmplx.NaN()
+	}
+	ζ, _, _ := besj(ν, complex(x, 0)*exp3πi4, kode)
+	return ζ
+}
+
+// berbeid computes ber'(ν,x) + i bei'(ν,x), scaled by exp(-x/√2) for KODE=2
+func berbeid(ν float64, x float64, kode int) complex128 {
+	if x < 0 || math.IsNaN(x) {
+		return cmplx.NaN()
+	}
+	ζ, _, _ := besjd(ν, complex(x, 0)*exp3πi4, kode)
+	return exp3πi4 * ζ
+}
+
+// kerkei computes ker(ν,x) + i kei(ν,x), scaled by exp(x/√2) for KODE=2
+func kerkei(ν float64, x float64, kode int) complex128 {
+	if x < 0 || math.IsNaN(x) {
+		return cmplx.NaN()
+	}
+	if x == 0 {
+		if ν == 0 {
+			return complex(math.Inf(1), -math.Pi/4)
+		}
+		return cmplx.NaN()
+	}
+	z := complex(x, 0) * expπi4
+	ζ, _, _ := besk(ν, z, kode)
+	return kelvinPhase(ν, z, kode) * ζ
+}
+
+// kerkeid computes ker'(ν,x) + i kei'(ν,x), scaled by exp(x/√2) for KODE=2
+func kerkeid(ν float64, x float64, kode int) complex128 {
+	if x < 0 || math.IsNaN(x) {
+		return cmplx.NaN()
+	}
+	if x == 0 {
+		if ν == 0 {
+			return complex(math.Inf(-1), 0)
+		}
+		return cmplx.NaN()
+	}
+	z := complex(x, 0) * expπi4
+	ζ, _, _ := beskd(ν, z, kode)
+	return kelvinPhase(ν, z, kode) * expπi4 * ζ
+}
+
+// kelvinPhase returns exp(-νπi/2), and for KODE=2 also exp(-i Im(z)) which reduces the
+// AMOS scaling exp(z) of K to exp(Re(z)) = exp(x/√2)
+func kelvinPhase(ν float64, z complex128, kode int) complex128 {
+	s, c := sincospi(ν / 2)
+	f := complex(c, -s)
+	if kode == 2 {
+		f *= cmplx.Exp(complex(0, -imag(z)))
+	}
+	return f
+}
@@ -0,0 +1,156 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"github.com/dreading/gospecfunc/bessel/internal/amos"
+	"github.com/dreading/gospecfunc/machine"
+	"math"
+)
+
+// For orders ν ≥ 20 the logarithms are computed from the Debye uniform asymptotic
+// expansions implemented by AMOS ZUNIK
+//    I(ν,νt) ~ exp(νη) / (√(2πν) (1+t²)^(1/4)) Σ U(k,p)/ν^k
+//    K(ν,νt) ~ exp(-νη) √(π/(2ν)) / (1+t²)^(1/4) Σ (-1)^k U(k,p)/ν^k
+// with p = 1/√(1+t²) and η = √(1+t²) + log(t/(1+√(1+t²))), which are accurate to machine
+// precision uniformly in x at these orders. See DLMF 10.41. For lower orders the logarithms
+// of the exponentially scaled functions from AMOS are used. These only overflow or underflow
+// for very small x, where the leading terms of the power series are used instead, and AMOS
+// only refuses very large x, where Hankel's expansions are used instead.
+
+// debyeOrder is the order from which the Debye expansions are used
+const debyeOrder = 20
+
+// LogI computes the natural logarithm of the bessel function I(ν,x) for ν ≥ 0 and x ≥ 0
+func LogI(ν float64, x float64) float64 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	switch {
+	case x < 0 || math.IsNaN(x):
+		return math.NaN()
+	case x == 0:
+		if ν == 0 {
+			return 0
+		}
+		return math.Inf(-1)
+	case math.IsInf(x, 1):
+		return math.Inf(1)
+	}
+	return logBessel(ν, x, 1)
+}
+
+// LogK computes the natural logarithm of the bessel function K(ν,x) for x ≥ 0
+func LogK(ν float64, x float64) float64 {
+	// K(-ν,x) = K(ν,x)
+	ν = math.Abs(ν)
+	switch {
+	case x < 0 || math.IsNaN(x) || math.IsNaN(ν):
+		return math.NaN()
+	case x == 0:
+		return math.Inf(1)
+	case math.IsInf(x, 1):
+		return math.Inf(-1)
+	}
+	return logBessel(ν, x, 2)
+}
+
+// logBessel computes the logarithm of I (IKFLG=1) or K (IKFLG=2) of order ν ≥ 0 for 0 < x < ∞
+func logBessel(ν float64, x float64, ikflg int) float64 {
+	if ν >= debyeOrder {
+		// outside these limits ZUNIK overflows
+		if x > ν*1e-300 && x < ν*1e150 {
+			return debye(ν, x, ikflg)
+		}
+	} else {
+		var ζ complex128
+		var NZ int
+		var err error
+		if ikflg == 1 {
+			ζ, NZ, err = besi(ν, complex(x, 0), 2)
+		} else {
+			ζ, NZ, err = besk(ν, complex(x, 0), 2)
+		}
+		if (err == nil || err == ErrPrecisionLoss) && NZ == 0 && real(ζ) > 0 && !math.IsInf(real(ζ), 1) {
+			if ikflg == 1 {
+				return math.Log(real(ζ)) + x
+			}
+			return math.Log(real(ζ)) - x
+		}
+	}
+	if x < 1 {
+		return logSeries(ν, x, ikflg)
+	}
+	return logHankel(ν, x, ikflg)
+}
+
+// debye computes the logarithm of I or K from the Debye expansions of AMOS ZUNIK
+func debye(ν float64, x float64, ikflg int) float64 {
+	CWRKR := make([]float64, 17)
+	CWRKI := make([]float64, 17)
+	TOL := math.Max(machine.D1MACH[4], 1.0e-18)
+	var PHIR, PHII, ZETA1R, ZETA1I, ZETA2R, ZETA2I, SUMR, SUMI float64
+	_, _, _, _, _, _, _, PHIR, _, ZETA1R, _, ZETA2R, _, SUMR, _, _, _ = amos.ZUNIK(x, 0, ν, ikflg, 0, TOL, 0,
+		PHIR, PHII, ZETA1R, ZETA1I, ZETA2R, ZETA2I, SUMR, SUMI, CWRKR, CWRKI)
+	if ikflg == 1 {
+		return math.Log(PHIR) + (ZETA2R - ZETA1R) + math.Log(SUMR)
+	}
+	return math.Log(PHIR) + (ZETA1R - ZETA2R) + math.Log(SUMR)
+}
+
+// logSeries computes the logarithm of I or K for very small x from the leading terms
+//    I(ν,x) ~ (x/2)^ν / Γ(ν+1)
+//    K(ν,x) ~ (Γ(ν) (x/2)^(-ν) + Γ(-ν) (x/2)^ν) / 2
+// where the second term of K is only significant for ν < 1, and K(0,x) ~ -log(x/2) - γ
+func logSeries(ν float64, x float64, ikflg int) float64 {
+	L := math.Ln2 - math.Log(x)
+	if ikflg == 1 {
+		lg, _ := math.Lgamma(ν + 1)
+		return -ν*L - lg
+	}
+	if ν == 0 {
+		return math.Log(L - 0.57721566490153286061)
+	}
+	if ν >= 1 {
+		lg, _ := math.Lgamma(ν)
+		return lg - math.Ln2 + ν*L
+	}
+	// K(ν,x) ~ (Γ(1+ν) exp(νL) - Γ(1-ν) exp(-νL)) / (2ν) = exp((a+b)/2) sinh(d)/ν
+	// with a = log Γ(1+ν), b = log Γ(1-ν) and d = νL + (a-b)/2
+	a, _ := math.Lgamma(1 + ν)
+	b, _ := math.Lgamma(1 - ν)
+	d := ν*L + (a-b)/2
+	logSinh := math.Log(math.Sinh(d))
+	if d > 1 {
+		logSinh = d - math.Ln2 + math.Log1p(-math.Exp(-2*d))
+	}
+	return (a+b)/2 + logSinh - math.Log(ν)
+}
+
+// logHankel computes the logarithm of I or K for large x from Hankel's expansions
+//    I(ν,x) ~ exp(x) / √(2πx) Σ (-1)^k a(k,ν)/x^k
+//    K(ν,x) ~ exp(-x) √(π/(2x)) Σ a(k,ν)/x^k
+// with a(k,ν) = (4ν²-1)(4ν²-9)...(4ν²-(2k-1)²) / (k! 8^k). See DLMF 10.40.
+func logHankel(ν float64, x float64, ikflg int) float64 {
+	μ := 4 * ν * ν
+	σ := 1.0
+	if ikflg == 1 {
+		σ = -1
+	}
+	s, t := 1.0, 1.0
+	for k := 1; k <= 30; k++ {
+		d := float64(2*k - 1)
+		t *= σ * (μ - d*d) / (8 * float64(k) * x)
+		s += t
+		if math.Abs(t) < machineEpsilon*math.Abs(s) {
+			break
+		}
+	}
+	lx := math.Log(x)
+	if ikflg == 1 {
+		return x - 0.5*(math.Log(2*math.Pi)+lx) + math.Log(s)
+	}
+	return -x + 0.5*(math.Log(math.Pi/2)-lx) + math.Log(s)
+}
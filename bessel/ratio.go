@@ -0,0 +1,296 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"github.com/dreading/gospecfunc/bessel/internal/amos"
+	"github.com/dreading/gospecfunc/machine"
+	"math"
+	"math/cmplx"
+)
+
+// The ratio I(ν+1,z)/I(ν,z) is computed by the backward recurrence of AMOS ZRATI, which
+// evaluates the continued fraction
+//    I(ν+1,z)/I(ν,z) = 1/(2(ν+1)/z + 1/(2(ν+2)/z + ...))
+// and the ratio of J follows from J(ν,z) = exp(νπi/2) I(ν,-iz). The function K is the
+// dominant solution of the recurrence in the order in the right half plane, so its ratio is
+// computed from the exponentially scaled K of orders μ and μ+1, 0 ≤ μ < 1, by forward
+// recurrence of the ratios, and in the left half plane by analytic continuation. None of the
+// ratios is formed from the separate functions of order ν and ν+1, so they remain finite when
+// those functions overflow or underflow.
+//
+// The backward recurrence of ZRATI starts at an order that grows like |z| and the forward
+// recurrence of K takes ν steps, so for |z| or ν above ratioLarge the ratios are instead taken
+// from Hankel's expansions for |z| ≥ ν² and otherwise, away from the turning points ±iν and, for
+// I, from the region where it oscillates, from the Debye expansions. These are written in terms
+// of s = √(ν²+z²) as
+//    I(ν,z) ~ exp(F(ν)) / √(2πs) Σ V(k,t²)/s^k
+//    K(ν,z) ~ exp(-F(ν)) √(π/(2s)) Σ (-1)^k V(k,t²)/s^k
+// with t = ν/s, F(ν) = s + ν log(z/(ν+s)) and V(k,t²) = U(k,t)/t^k, so that they remain valid
+// for small orders. F(ν+1) - F(ν) is formed without cancellation. See DLMF 10.17, 10.40 and 10.41.
+
+// ratioLarge is the size of |z| or ν from which the asymptotic expansions are used
+const ratioLarge = 1e4
+
+// RatioI computes the ratio I(ν+1,z)/I(ν,z) of bessel functions I for ν ≥ 0 and complex arguments
+func RatioI(ν float64, z complex128) complex128 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	if z == 0 {
+		return 0
+	}
+	if cmplx.IsNaN(z) {
+		return cmplx.NaN()
+	}
+	return ratioI(ν, z)
+}
+
+// RatioJ computes the ratio J(ν+1,z)/J(ν,z) of bessel functions J for ν ≥ 0 and complex arguments
+func RatioJ(ν float64, z complex128) complex128 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	if z == 0 {
+		return 0
+	}
+	if cmplx.IsNaN(z) {
+		return cmplx.NaN()
+	}
+	return 1i * ratioI(ν, -1i*z)
+}
+
+// RatioK computes the ratio K(ν+1,z)/K(ν,z) of bessel functions K for ν ≥ 0 and complex arguments
+func RatioK(ν float64, z complex128) complex128 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	if z == 0 {
+		return cmplx.Inf()
+	}
+	if cmplx.IsNaN(z) {
+		return cmplx.NaN()
+	}
+	a := cmplx.Abs(z)
+	if a >= ratioLarge && a >= ν*ν {
+		// K(ν,z) ~ √(π/(2z)) exp(-z) Σ a(k,ν)/z^k for |ph z| < 3π/2
+		return hankelK(ν+1, z) / hankelK(ν, z)
+	}
+	if real(z) >= 0 {
+		if math.Max(ν, a) >= ratioLarge && cmplx.Abs(complex(ν*ν, 0)+z*z) >= (ν*ν+a*a)/2 {
+			// away from the turning points ±iν
+			d, _, r := debyeRatio(ν, z)
+			return cmplx.Exp(-d) * r
+		}
+		n := math.Floor(ν)
+		return ratioK(ν-n, z, int(n))
+	}
+	return ratioKLeft(ν, z)
+}
+
+// ratioI computes I(ν+1,z)/I(ν,z) for z ≠ 0
+func ratioI(ν float64, z complex128) complex128 {
+	// I(ν,-z) = exp(±νπi) I(ν,z), so the ratio changes sign
+	w, σ := z, complex(1, 0)
+	if real(z) < 0 {
+		w, σ = -z, -1
+	}
+	a := cmplx.Abs(w)
+	switch {
+	case math.Max(ν, a) >= ratioLarge && (real(w) >= math.Abs(imag(w)) || a <= ν/2):
+		// within |ph z| ≤ π/4, or well inside the turning points ±iν
+		d, r, _ := debyeRatio(ν, w)
+		return σ * cmplx.Exp(d) * r
+	case a >= ratioLarge && a >= ν*ν:
+		// I(ν,w) = exp(∓νπi/2) J(ν,±iw) with Re(±iw) ≥ 0
+		if imag(w) < 0 {
+			return σ * -1i * hankelRatioJ(ν, 1i*w)
+		}
+		return σ * 1i * hankelRatioJ(ν, -1i*w)
+	}
+	var r [1]complex128
+	zrati(ν, z, r[:])
+	return r[0]
+}
+
+// ratioK computes K(μ+n+1,z)/K(μ+n,z) for Re(z) ≥ 0 by forward recurrence from the
+// exponentially scaled K of orders μ and μ+1
+func ratioK(μ float64, z complex128, n int) complex128 {
+	var cy [2]complex128
+	zbesk(μ, z, 2, 2, cy[:])
+	r := cy[1] / cy[0]
+	for k := 1; k <= n; k++ {
+		r = nextRatioK(μ+float64(k), z, r)
+	}
+	return r
+}
+
+// nextRatioK computes K(ν+1,z)/K(ν,z) = 2ν/z + K(ν-1,z)/K(ν,z) from r = K(ν,z)/K(ν-1,z)
+func nextRatioK(ν float64, z complex128, r complex128) complex128 {
+	return complex(2*ν, 0)/z + 1/r
+}
+
+// ratioKLeft computes K(ν+1,z)/K(ν,z) for Re(z) < 0, where forward recurrence is unstable for orders
+// below |z|, from the analytic continuation with w = -z = z exp(-mπi)
+//    K(ν,z) = exp(-mνπi) K(ν,w) - mπi I(ν,w)
+// in terms of the ratios of K and I at w and κ = K(ν,w)/I(ν,w), which is carried as a logarithm
+func ratioKLeft(ν float64, z complex128) complex128 {
+	w := -z
+	m := 1.0
+	if imag(z) < 0 {
+		m = -1
+	}
+	n := int(math.Floor(ν))
+	μ := ν - float64(n)
+	rk := ratioK(μ, w, 0)
+	ri := make([]complex128, n+1)
+	zrati(μ, w, ri)
+
+	// log κ(μ) from the scaled functions Kx = exp(w) K and Ix = exp(-Re(w)) I
+	var ck, ci [1]complex128
+	zbesk(μ, w, 2, 1, ck[:])
+	zbesi(μ, w, 2, 1, ci[:])
+	L := cmplx.Log(ck[0]/ci[0]) - w - complex(real(w), 0)
+	for k := 0; k < n; k++ {
+		L += cmplx.Log(rk / ri[k])
+		rk = nextRatioK(μ+float64(k+1), w, rk)
+	}
+
+	s, c := sincospi(ν)
+	p := complex(c, -m*s)
+	mπi := complex(0, m*math.Pi)
+	if real(L) > 0 {
+		// divide through by κ, whose reciprocal may harmlessly underflow
+		κ1 := cmplx.Exp(-L)
+		return (-p*rk - mπi*ri[n]*κ1) / (p - mπi*κ1)
+	}
+	κ := cmplx.Exp(L)
+	return (-p*κ*rk - mπi*ri[n]) / (p*κ - mπi)
+}
+
+// hankelK computes the sum Σ a(k,ν)/z^k in Hankel's expansion of K, with
+//    a(k,ν) = (4ν²-1)(4ν²-9)...(4ν²-(2k-1)²) / (k! 8^k)
+func hankelK(ν float64, z complex128) complex128 {
+	s, t := complex(1, 0), complex(1, 0)
+	for k := 1; k <= 60; k++ {
+		d := float64(2*k - 1)
+		t *= complex((2*ν-d)*(2*ν+d)/(8*float64(k)), 0) / z
+		s += t
+		if cmplx.Abs(t) < machineEpsilon*cmplx.Abs(s) {
+			break
+		}
+	}
+	return s
+}
+
+// hankelRatioJ computes J(ν+1,w)/J(ν,w) for Re(w) ≥ 0 and |w| ≥ ν² from Hankel's expansion
+//    J(ν,w) ~ √(2/(πw)) (P(ν,w) cos(ω) - Q(ν,w) sin(ω))
+// with ω = w - (ν/2+1/4)π, P = Σ (-1)^k a(2k,ν)/w^(2k) and Q = Σ (-1)^k a(2k+1,ν)/w^(2k+1),
+// in which ω decreases by π/2 for the order ν+1
+func hankelRatioJ(ν float64, w complex128) complex128 {
+	p0, q0 := hankelPQ(ν, w)
+	p1, q1 := hankelPQ(ν+1, w)
+	ω := w - complex(math.Mod(ν/2+0.25, 2)*math.Pi, 0)
+	if math.Abs(imag(ω)) > 20 {
+		// tan(ω) = ±i to machine precision, where sin(ω) and cos(ω) may overflow
+		τ := complex(0, math.Copysign(1, imag(ω)))
+		return (p1*τ + q1) / (p0 - q0*τ)
+	}
+	s, c := cmplx.Sin(ω), cmplx.Cos(ω)
+	return (p1*s + q1*c) / (p0*c - q0*s)
+}
+
+// hankelPQ computes P(ν,w) and Q(ν,w) of Hankel's expansion of J
+func hankelPQ(ν float64, w complex128) (complex128, complex128) {
+	pq := [2]complex128{1, 0}
+	t := complex(1, 0)
+	for k := 1; k <= 60; k++ {
+		d := float64(2*k - 1)
+		t *= complex((2*ν-d)*(2*ν+d)/(8*float64(k)), 0) / w
+		if k%4 < 2 {
+			pq[k%2] += t
+		} else {
+			pq[k%2] -= t
+		}
+		if cmplx.Abs(t) < machineEpsilon*cmplx.Abs(pq[0]) {
+			break
+		}
+	}
+	return pq[0], pq[1]
+}
+
+// The coefficients of V(k,p) = U(k,t)/t^k in ascending powers of p = t², k = 1, ..., 4, of the
+// Debye expansions
+var debyeV = [][]float64{
+	{1.0 / 8, -5.0 / 24},
+	{9.0 / 128, -77.0 / 192, 385.0 / 1152},
+	{75.0 / 1024, -4563.0 / 5120, 17017.0 / 9216, -85085.0 / 82944},
+	{3675.0 / 32768, -96833.0 / 40960, 144001.0 / 16384, -7436429.0 / 663552, 37182145.0 / 7962624},
+}
+
+// debyeRatio computes d = F(ν+1) - F(ν) of the Debye expansions and the quotients of their
+// remaining factors √(1/s) Σ (±1)^k V(k,t²)/s^k at orders ν+1 and ν for I and K, which are
+// accurate when ν or |z| is at least ratioLarge and s is not small compared with them
+func debyeRatio(ν float64, z complex128) (d, ri, rk complex128) {
+	ν0, ν1 := complex(ν, 0), complex(ν+1, 0)
+	s0 := cmplx.Sqrt(ν0*ν0 + z*z)
+	s1 := cmplx.Sqrt(ν1*ν1 + z*z)
+	// s1 - s0 = (2ν+1)/(s1+s0) and (ν+1+s1)/(ν+s0) = 1 + (1+s1-s0)/(ν+s0)
+	δ := complex(2*ν+1, 0) / (s1 + s0)
+	d = δ + cmplx.Log(z/(ν1+s1)) - ν0*log1pc((1+δ)/(ν0+s0))
+	i0, k0 := debyeSums(ν0/s0, s0)
+	i1, k1 := debyeSums(ν1/s1, s1)
+	q := cmplx.Sqrt(s0 / s1)
+	return d, q * i1 / i0, q * k1 / k0
+}
+
+// debyeSums computes Σ V(k,t²)/s^k and Σ (-1)^k V(k,t²)/s^k
+func debyeSums(t, s complex128) (complex128, complex128) {
+	p := t * t
+	x, xk := 1/s, complex(1, 0)
+	plus, minus := complex(1, 0), complex(1, 0)
+	for k, c := range debyeV {
+		v := complex(0, 0)
+		for j := len(c) - 1; j >= 0; j-- {
+			v = v*p + complex(c[j], 0)
+		}
+		xk *= x
+		plus += v * xk
+		if k%2 == 0 {
+			minus -= v * xk
+		} else {
+			minus += v * xk
+		}
+	}
+	return plus, minus
+}
+
+// log1pc computes log(1+u) for complex u without cancellation for small |u|
+func log1pc(u complex128) complex128 {
+	if cmplx.Abs(u) > 0.1 {
+		return cmplx.Log(1 + u)
+	}
+	s, t := complex(0, 0), complex(-1, 0)
+	for k := 1; k <= 20; k++ {
+		t *= -u
+		s += t / complex(float64(k), 0)
+		if cmplx.Abs(t) < machineEpsilon*cmplx.Abs(s) {
+			break
+		}
+	}
+	return s
+}
+
+// zrati computes I(ν+k+1,z)/I(ν+k,z), k = 0, ..., len(dst)-1, for z ≠ 0 using a single AMOS call
+func zrati(ν float64, z complex128, dst []complex128) {
+	n := len(dst)
+	CYR := make([]float64, n+1)
+	CYI := make([]float64, n+1)
+	TOL := math.Max(machine.D1MACH[4], 1.0e-18)
+	_, _, _, _, CYR, CYI, _ = amos.ZRATI(real(z), imag(z), ν, n, CYR, CYI, TOL)
+	for k := range dst {
+		dst[k] = complex(CYR[k+1], CYI[k+1])
+	}
+}
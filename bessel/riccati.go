@@ -0,0 +1,96 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math/cmplx"
+)
+
+// The Riccati-Bessel functions follow the convention of Bohren and Huffman
+//    ψ(n,z) = z j(n,z),  χ(n,z) = -z y(n,z),  ξ(n,z) = ψ(n,z) - i χ(n,z) = z h1(n,z)
+// used for the Mie scattering coefficients.
+
+// RiccatiPsi computes the Riccati-Bessel function ψ(n,z) = z j(n,z) for complex arguments
+func RiccatiPsi(n int, z complex128) complex128 {
+	return z * SphericalJ(n, z)
+}
+
+// RiccatiPsiSeq computes the m member sequence of Riccati-Bessel functions ψ of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func RiccatiPsiSeq(n int, m int, z complex128, dst []complex128) {
+	SphericalJSeq(n, m, z, dst)
+	scale(z, dst[:m])
+}
+
+// RiccatiChi computes the Riccati-Bessel function χ(n,z) = -z y(n,z) for complex arguments
+func RiccatiChi(n int, z complex128) complex128 {
+	return -z * SphericalY(n, z)
+}
+
+// RiccatiChiSeq computes the m member sequence of Riccati-Bessel functions χ of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func RiccatiChiSeq(n int, m int, z complex128, dst []complex128) {
+	SphericalYSeq(n, m, z, dst)
+	scale(-z, dst[:m])
+}
+
+// RiccatiXi computes the Riccati-Bessel function ξ(n,z) = z h1(n,z) for complex arguments
+func RiccatiXi(n int, z complex128) complex128 {
+	return z * SphericalH1(n, z)
+}
+
+// RiccatiXiSeq computes the m member sequence of Riccati-Bessel functions ξ of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func RiccatiXiSeq(n int, m int, z complex128, dst []complex128) {
+	SphericalH1Seq(n, m, z, dst)
+	scale(z, dst[:m])
+}
+
+// RiccatiD computes the logarithmic derivative D(n,z) = ψ'(n,z)/ψ(n,z) of the
+// Riccati-Bessel function ψ for complex arguments
+func RiccatiD(n int, z complex128) complex128 {
+	if n < 0 {
+		panic("order must be non-negative")
+	}
+	dst := make([]complex128, 1)
+	RiccatiDSeq(n, 1, z, dst)
+	return dst[0]
+}
+
+// RiccatiDSeq computes the m member sequence of logarithmic derivatives D = ψ'/ψ of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst. D(n+m-1,z) is found from the continued
+// fraction for ψ(n+m-2,z)/ψ(n+m-1,z) and the lower orders by the downward recurrence
+//    D(k-1,z) = k/z - 1/(D(k,z) + k/z)
+// which remains stable for complex z with a large imaginary part.
+func RiccatiDSeq(n int, m int, z complex128, dst []complex128) {
+	if n < 0 {
+		panic("order must be non-negative")
+	}
+	if m < 1 {
+		panic("m must be positive")
+	}
+	if len(dst) < m {
+		panic("dst is too short")
+	}
+	if z == 0 {
+		fill(dst[:m], cmplx.Inf(), nil)
+		return
+	}
+	N := n + m - 1
+	// start one order higher so that D(0,z) = cot(z) is also given by the recurrence
+	D := 1/sphjRatio(N+1, z) - complex(float64(N+1), 0)/z
+	for k := N + 1; k > n; k-- {
+		κ := complex(float64(k), 0) / z
+		D = κ - 1/(D+κ)
+		dst[k-1-n] = D
+	}
+}
+
+// scale multiplies each element of dst by z
+func scale(z complex128, dst []complex128) {
+	for k := range dst {
+		dst[k] *= z
+	}
+}
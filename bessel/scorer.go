@@ -0,0 +1,204 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The Scorer functions of complex argument are computed for |z| ≤ 1 from the power series
+//    Hi(z) = 3^(-2/3)/π Σ Γ((k+1)/3) (3^(1/3) z)^k / k!
+//    Gi(z) = 3^(-2/3)/π Σ cos((2k-1)π/3) Γ((k+1)/3) (3^(1/3) z)^k / k!
+// and otherwise from the integral representation
+//    Hi(z) = 1/π ∫ exp(-t³/3 + zt) dt, 0 ≤ t < ∞
+// which for Re(z) ≤ 0 is evaluated by Gauss-Legendre quadrature along a ray t = s exp(iα),
+// |α| ≤ π/12, turned towards the negative real axis so that the integrand decays quickly and
+// oscillates little. In the right half plane, where Hi grows like Bi, and for Gi, which is
+// small in the sector |ph(z)| < π/3, the integral is only evaluated in the left half plane
+// through the connection formulas
+//    Hi(z) = exp(±2πi/3) Hi(z exp(±2πi/3)) + 2 exp(∓πi/6) Ai(z exp(∓2πi/3))
+//    Gi(z) = (exp(πi/6) Hi(z exp(2πi/3)) - exp(-πi/6) Hi(z exp(-2πi/3))) / 2i
+//    Gi(z) = Bi(z) - Hi(z)
+// of which the last is used for Re(z) ≤ 0, where Gi is dominated by Bi. The derivatives
+// follow in the same way. See DLMF 9.12 and A. Gil, J. Segura and N. M. Temme, Computing
+// the Scorer functions and their derivatives, ACM Trans. Math. Softw. 28 (2002) 436-447.
+
+// Γ(1/3) and Γ(2/3)
+const (
+	gamma13 = 2.678938534707747633655692940974677644128689377957301100950
+	gamma23 = 1.354117939426400416945288028154513785519327266056793698394
+)
+
+var (
+	exp2πi3 = complex(-0.5, math.Sqrt(3)/2) // exp(2πi/3)
+	expπi6  = complex(math.Sqrt(3)/2, 0.5)  // exp(πi/6)
+)
+
+// Gic computes the Scorer function Gi for complex arguments
+func Gic(z complex128) complex128 {
+	return scorerGi(z, 0)
+}
+
+// Gid computes the first derivative of the Scorer function Gi for complex arguments dGi(z)/dz
+func Gid(z complex128) complex128 {
+	return scorerGi(z, 1)
+}
+
+// Hic computes the Scorer function Hi for complex arguments
+func Hic(z complex128) complex128 {
+	return scorerHi(z, 0)
+}
+
+// Hid computes the first derivative of the Scorer function Hi for complex arguments dHi(z)/dz
+func Hid(z complex128) complex128 {
+	return scorerHi(z, 1)
+}
+
+// scorerGi computes Gi (id=0) or Gi' (id=1), which are real on the real axis
+func scorerGi(z complex128, id int) complex128 {
+	switch {
+	case cmplx.IsNaN(z):
+		return cmplx.NaN()
+	case cmplx.IsInf(z):
+		// as for real arguments Gi and Gi' vanish as z → ±∞, and the limit does not exist otherwise
+		if imag(z) == 0 {
+			return 0
+		}
+		return cmplx.NaN()
+	case imag(z) == 0:
+		return complex(real(gi(z, id)), 0)
+	}
+	return gi(z, id)
+}
+
+// scorerHi computes Hi (id=0) or Hi' (id=1), which are real on the real axis
+func scorerHi(z complex128, id int) complex128 {
+	switch {
+	case cmplx.IsNaN(z):
+		return cmplx.NaN()
+	case cmplx.IsInf(z):
+		// as for real arguments Hi and Hi' vanish as z → -∞ and grow without bound as z → ∞
+		if imag(z) == 0 && real(z) < 0 {
+			return 0
+		}
+		if imag(z) == 0 {
+			return complex(math.Inf(1), 0)
+		}
+		return cmplx.NaN()
+	case imag(z) == 0:
+		return complex(real(hi(z, id)), 0)
+	}
+	return hi(z, id)
+}
+
+// gi computes Gi or Gi' for finite z
+func gi(z complex128, id int) complex128 {
+	switch {
+	case cmplx.Abs(z) <= 1:
+		_, ζ := scorerSeries(z, id)
+		return ζ
+	case imag(z) < 0:
+		return cmplx.Conj(gi(cmplx.Conj(z), id))
+	case real(z) <= 0:
+		if id == 0 {
+			return Bi(z) - hi(z, 0)
+		}
+		return Bid(z) - hi(z, 1)
+	}
+	a, b := hi(z*exp2πi3, id), hi(z*cmplx.Conj(exp2πi3), id)
+	if id == 0 {
+		return (expπi6*a - cmplx.Conj(expπi6)*b) / 2i
+	}
+	// d/dz Hi(z exp(±2πi/3)) = exp(±2πi/3) Hi'(z exp(±2πi/3))
+	return (expπi6*exp2πi3*a - cmplx.Conj(expπi6*exp2πi3)*b) / 2i
+}
+
+// hi computes Hi or Hi' for finite z
+func hi(z complex128, id int) complex128 {
+	switch {
+	case cmplx.Abs(z) <= 1:
+		ζ, _ := scorerSeries(z, id)
+		return ζ
+	case imag(z) < 0:
+		return cmplx.Conj(hi(cmplx.Conj(z), id))
+	case real(z) <= 0:
+		return hiRay(z, id)
+	}
+	// z exp(2πi/3) lies in the left half plane for 0 ≤ ph(z) < π/2
+	w := cmplx.Conj(exp2πi3) * z
+	if id == 0 {
+		return exp2πi3*hi(z*exp2πi3, 0) + 2*cmplx.Conj(expπi6)*Ai(w)
+	}
+	return exp2πi3*exp2πi3*hi(z*exp2πi3, 1) + 2*cmplx.Conj(expπi6*exp2πi3)*Aid(w)
+}
+
+// scorerSeries sums the power series of Hi and Gi, or of their derivatives, as three
+// series in z³ whose terms satisfy c(k+3) = c(k) z³ / ((k+2)(k+3)) with c(k) the k-th term
+// of the series of Hi
+func scorerSeries(z complex128, id int) (complex128, complex128) {
+	c3 := math.Cbrt(3)
+	z3 := z * z * z
+	var s [3]complex128
+	for j, a := range []float64{gamma13, gamma23 * c3, c3 * c3 / 2} {
+		// the term a(k) z^k, or its derivative k a(k) z^(k-1)
+		k := j
+		p := complex(1, 0)
+		for i := 0; i < k-id; i++ {
+			p *= z
+		}
+		if id == 1 && k == 0 {
+			// the constant term does not contribute to the derivative, so start from k = 3
+			a /= 6
+			k = 3
+			p = z * z
+		}
+		for n := 0; n < 100; n++ {
+			t := complex(a*math.Pow(float64(k), float64(id)), 0) * p
+			s[j] += t
+			if cmplx.Abs(t) <= machineEpsilon*cmplx.Abs(s[j])/4 {
+				break
+			}
+			a /= float64((k + 2) * (k + 3))
+			p *= z3
+			k += 3
+		}
+	}
+	f := complex(1/(c3*c3*math.Pi), 0)
+	return f * (s[0] + s[1] + s[2]), f * (s[0]/2 + s[1]/2 - s[2])
+}
+
+// hiRay computes Hi(z) or Hi'(z) for Re(z) ≤ 0 and Im(z) ≥ 0 from the integral representation
+// along the ray t = s exp(iα) with α = min(π/12, π - ph(z)). The exponent -t³/3 + zt then
+// decreases monotonically along the ray, and the panels of the quadrature are no wider than
+// 2/|z - t²|, the reciprocal of the rate of change of the exponent.
+func hiRay(z complex128, id int) complex128 {
+	α := math.Min(math.Pi/12, math.Pi-cmplx.Phase(z))
+	e := cmplx.Rect(1, α)
+	f := func(s float64) complex128 {
+		t := complex(s, 0) * e
+		g := cmplx.Exp(-t*t*t/3 + z*t) * e
+		if id == 1 {
+			g *= t
+		}
+		return g
+	}
+	var sum complex128
+	a := 0.0
+	for k := 0; k < 100000; k++ {
+		t := complex(a, 0) * e
+		w := math.Min(0.5, 2/cmplx.Abs(z-t*t))
+		var panel complex128
+		for i, ξ := range legendre20.x {
+			panel += complex(w/2*legendre20.w[i], 0) * f(a+w*(1+ξ)/2)
+		}
+		sum += panel
+		a += w
+		if cmplx.Abs(panel) <= machineEpsilon*cmplx.Abs(sum)/16 && cmplx.Abs(f(a)) <= machineEpsilon*cmplx.Abs(sum)/16 {
+			break
+		}
+	}
+	return sum / math.Pi
+}
@@ -0,0 +1,452 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The spherical bessel functions of orders 0 and 1 have closed forms. The function j (and
+// hence i) is computed by its power series for small |z|, otherwise by forward recurrence
+// for real z and orders up to |z|, and from the ratios j(n)/j(n-1) given by a continued
+// fraction and backward recurrence. The function k is computed by forward recurrence in
+// the right half plane and by reflection in the left half plane, and the hankel functions
+// and y (for complex z) are computed from k.
+
+// SphericalJ computes the spherical bessel function j(n,z) = √(π/2z) J(n+½,z) for complex arguments
+func SphericalJ(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphj)
+}
+
+// SphericalJx computes the expontentially scaled spherical bessel function j for complex arguments
+// SphericalJx = e^(-|Im(z)|) * j(n,z)
+func SphericalJx(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphj)
+}
+
+// SphericalJSeq computes the m member sequence of spherical bessel functions j of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalJSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphj)
+}
+
+// SphericalJxSeq computes the m member sequence of expontentially scaled spherical bessel
+// functions j of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalJxSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphj)
+}
+
+// SphericalY computes the spherical bessel function y(n,z) = √(π/2z) Y(n+½,z) for complex arguments
+func SphericalY(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphy)
+}
+
+// SphericalYx computes the expontentially scaled spherical bessel function y for complex arguments
+// SphericalYx = e^(-|Im(z)|) * y(n,z)
+func SphericalYx(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphy)
+}
+
+// SphericalYSeq computes the m member sequence of spherical bessel functions y of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalYSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphy)
+}
+
+// SphericalYxSeq computes the m member sequence of expontentially scaled spherical bessel
+// functions y of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalYxSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphy)
+}
+
+// SphericalI computes the modified spherical bessel function i(n,z) = √(π/2z) I(n+½,z) for complex arguments
+func SphericalI(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphi)
+}
+
+// SphericalIx computes the expontentially scaled modified spherical bessel function i for complex arguments
+// SphericalIx = e^(-|Re(z)|) * i(n,z)
+func SphericalIx(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphi)
+}
+
+// SphericalISeq computes the m member sequence of modified spherical bessel functions i of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalISeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphi)
+}
+
+// SphericalIxSeq computes the m member sequence of expontentially scaled modified spherical bessel
+// functions i of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalIxSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphi)
+}
+
+// SphericalK computes the modified spherical bessel function k(n,z) = √(π/2z) K(n+½,z) for complex arguments
+func SphericalK(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphk)
+}
+
+// SphericalKx computes the expontentially scaled modified spherical bessel function k for complex arguments
+// SphericalKx = e^z * k(n,z)
+func SphericalKx(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphk)
+}
+
+// SphericalKSeq computes the m member sequence of modified spherical bessel functions k of orders
+// n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalKSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphk)
+}
+
+// SphericalKxSeq computes the m member sequence of expontentially scaled modified spherical bessel
+// functions k of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalKxSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphk)
+}
+
+// SphericalH1 computes the spherical hankel function of the first kind h1(n,z) = j(n,z) + i y(n,z)
+// for complex arguments
+func SphericalH1(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphh1)
+}
+
+// SphericalH1x computes the expontentially scaled spherical hankel function of the first kind
+// for complex arguments
+// SphericalH1x = e^(-iz) * h1(n,z)
+func SphericalH1x(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphh1)
+}
+
+// SphericalH1Seq computes the m member sequence of spherical hankel functions of the first kind
+// of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalH1Seq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphh1)
+}
+
+// SphericalH1xSeq computes the m member sequence of expontentially scaled spherical hankel functions
+// of the first kind of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalH1xSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphh1)
+}
+
+// SphericalH2 computes the spherical hankel function of the second kind h2(n,z) = j(n,z) - i y(n,z)
+// for complex arguments
+func SphericalH2(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 1, sphh2)
+}
+
+// SphericalH2x computes the expontentially scaled spherical hankel function of the second kind
+// for complex arguments
+// SphericalH2x = e^(iz) * h2(n,z)
+func SphericalH2x(n int, z complex128) complex128 {
+	return sphericalOne(n, z, 2, sphh2)
+}
+
+// SphericalH2Seq computes the m member sequence of spherical hankel functions of the second kind
+// of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalH2Seq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 1, dst, sphh2)
+}
+
+// SphericalH2xSeq computes the m member sequence of expontentially scaled spherical hankel functions
+// of the second kind of orders n, n+1, ..., n+m-1 for complex arguments into dst
+func SphericalH2xSeq(n int, m int, z complex128, dst []complex128) {
+	sphericalSeq(n, m, z, 2, dst, sphh2)
+}
+
+// sphericalOne computes the single order n using the sequence of orders 0, ..., n
+func sphericalOne(n int, z complex128, kode int, f func(complex128, int, []complex128)) complex128 {
+	if n < 0 {
+		panic("order must be non-negative")
+	}
+	dst := make([]complex128, n+1)
+	f(z, kode, dst)
+	return dst[n]
+}
+
+// sphericalSeq computes the orders n, ..., n+m-1 into dst using the sequence of orders 0, ..., n+m-1
+func sphericalSeq(n int, m int, z complex128, kode int, dst []complex128, f func(complex128, int, []complex128)) {
+	if n < 0 {
+		panic("order must be non-negative")
+	}
+	if m < 1 {
+		panic("m must be positive")
+	}
+	if len(dst) < m {
+		panic("dst is too short")
+	}
+	if n == 0 {
+		f(z, kode, dst[:m])
+		return
+	}
+	work := make([]complex128, n+m)
+	f(z, kode, work)
+	copy(dst, work[n:])
+}
+
+// sincosx returns e^(-|Im(z)|) sin(z) and e^(-|Im(z)|) cos(z) for KODE=2, and sin(z) and cos(z) otherwise
+func sincosx(z complex128, kode int) (complex128, complex128) {
+	if kode == 1 {
+		return cmplx.Sin(z), cmplx.Cos(z)
+	}
+	a := complex(-math.Abs(imag(z)), 0)
+	p := cmplx.Exp(1i*z + a)
+	q := cmplx.Exp(-1i*z + a)
+	return (p - q) / 2i, (p + q) / 2
+}
+
+// sphj computes j(k,z), k = 0, ..., len(dst)-1, optionally scaled by e^(-|Im(z)|)
+func sphj(z complex128, kode int, dst []complex128) {
+	N := len(dst) - 1
+	az := cmplx.Abs(z)
+	if az < 1 {
+		sphjSeries(z, kode, dst)
+		return
+	}
+	s, c := sincosx(z, kode)
+	if cmplx.IsInf(s) || cmplx.IsNaN(s) {
+		// sin(z) overflows for large |Im(z)| without scaling
+		fill(dst, cmplx.Inf(), nil)
+		return
+	}
+	j0 := s / z
+	j1 := (s/z - c) / z
+	dst[0] = j0
+	if N == 0 {
+		return
+	}
+	dst[1] = j1
+
+	// for real z forward recurrence is stable while the order does not exceed |z|
+	K := 1
+	if imag(z) == 0 && az > 1 {
+		K = int(math.Min(az, float64(N)))
+	}
+	for k := 1; k < K; k++ {
+		dst[k+1] = complex(float64(2*k+1), 0)/z*dst[k] - dst[k-1]
+	}
+	if K == N {
+		return
+	}
+
+	// backward recurrence of the ratios r(k) = j(k)/j(k-1) from the continued fraction for r(N)
+	r := sphjRatio(N, z)
+	dst[N] = r
+	for k := N - 1; k > K; k-- {
+		r = 1 / (complex(float64(2*k+1), 0)/z - r)
+		dst[k] = r
+	}
+	if K == 1 && cmplx.Abs(j0) > cmplx.Abs(j1) {
+		// j(1) is near a zero, normalise with j(0) instead
+		dst[1] = j0 / (3/z - r)
+	}
+	for k := K + 1; k <= N; k++ {
+		dst[k] *= dst[k-1]
+	}
+}
+
+// sphjRatio computes j(n,z)/j(n-1,z) for n >= 1 from the continued fraction
+//    j(n-1)/j(n) = b(n) - 1/(b(n+1) - 1/(b(n+2) - ...)),  b(k) = (2k+1)/z
+// using the modified Lentz method.
+func sphjRatio(n int, z complex128) complex128 {
+	const (
+		tiny = 1e-300
+		eps  = 1e-16
+	)
+	b := complex(float64(2*n+1), 0) / z
+	f := b
+	if f == 0 {
+		f = tiny
+	}
+	C := f
+	var D complex128
+	for k := n + 1; k < n+4*int(cmplx.Abs(z))+1000; k++ {
+		b = complex(float64(2*k+1), 0) / z
+		D = b - D
+		if D == 0 {
+			D = tiny
+		}
+		C = b - 1/C
+		if C == 0 {
+			C = tiny
+		}
+		D = 1 / D
+		Δ := C * D
+		f *= Δ
+		if cmplx.Abs(Δ-1) < eps {
+			break
+		}
+	}
+	return 1 / f
+}
+
+// sphjSeries computes j(k,z), k = 0, ..., len(dst)-1 for |z| < 1 from the power series
+//    j(k,z) = z^k/(2k+1)!! Σ (-z²/2)^m / (m! (2k+3)(2k+5)...(2k+2m+1))
+func sphjSeries(z complex128, kode int, dst []complex128) {
+	scale := complex(1, 0)
+	if kode == 2 {
+		scale = complex(math.Exp(-math.Abs(imag(z))), 0)
+	}
+	h := -z * z / 2
+	lead := scale
+	for k := range dst {
+		sum := complex(1, 0)
+		term := complex(1, 0)
+		for m := 1; m < 100; m++ {
+			term *= h / complex(float64(m*(2*k+2*m+1)), 0)
+			sum += term
+			if cmplx.Abs(term) < 1e-17*cmplx.Abs(sum) {
+				break
+			}
+		}
+		dst[k] = lead * sum
+		lead *= z / complex(float64(2*k+3), 0)
+	}
+}
+
+// sphy computes y(k,z), k = 0, ..., len(dst)-1, optionally scaled by e^(-|Im(z)|)
+func sphy(z complex128, kode int, dst []complex128) {
+	if z == 0 {
+		fill(dst, cmplx.Inf(), nil)
+		return
+	}
+	if imag(z) == 0 {
+		s, c := sincosx(z, kode)
+		dst[0] = -c / z
+		if len(dst) > 1 {
+			dst[1] = -(c/z + s) / z
+		}
+		recur(z, 1, dst)
+		return
+	}
+	// y = (h1 - h2)/2i where the larger of h1 and h2 dominates
+	h2 := make([]complex128, len(dst))
+	sphh(z, kode, 1, dst)
+	sphh(z, kode, 2, h2)
+	f1, f2 := complex(1, 0), complex(1, 0)
+	if kode == 2 {
+		// restore e^(iz) and e^(-iz) relative to the scale e^(|Im(z)|)
+		a := complex(-math.Abs(imag(z)), 0)
+		f1 = cmplx.Exp(1i*z + a)
+		f2 = cmplx.Exp(-1i*z + a)
+	}
+	for k := range dst {
+		if cmplx.IsInf(dst[k]) || cmplx.IsInf(h2[k]) {
+			fill(dst[k:], cmplx.Inf(), nil)
+			return
+		}
+		dst[k] = (f1*dst[k] - f2*h2[k]) / 2i
+	}
+}
+
+// sphi computes i(k,z) = i^(-k) j(k,iz), k = 0, ..., len(dst)-1, optionally scaled by e^(-|Re(z)|)
+func sphi(z complex128, kode int, dst []complex128) {
+	sphj(1i*z, kode, dst)
+	f := complex(1, 0)
+	for k := range dst {
+		if cmplx.IsInf(dst[k]) {
+			fill(dst[k:], cmplx.Inf(), nil)
+			return
+		}
+		dst[k] *= f
+		f *= -1i
+	}
+}
+
+// sphk computes k(k,z), k = 0, ..., len(dst)-1, optionally scaled by e^z.
+// In the left half plane the reflection formula
+//    k(n,z) = (-1)^(n+1) (k(n,-z) + π i(n,z))
+// is used, since k(n,z) is not then the dominant solution of the recurrence.
+func sphk(z complex128, kode int, dst []complex128) {
+	if z == 0 {
+		fill(dst, cmplx.Inf(), nil)
+		return
+	}
+	if real(z) < 0 {
+		i := make([]complex128, len(dst))
+		sphk(-z, kode, dst)
+		sphi(z, kode, i)
+		fk, fi := complex(1, 0), complex(math.Pi, 0)
+		if kode == 2 {
+			fk = cmplx.Exp(2 * z)
+			fi = cmplx.Exp(complex(0, imag(z))) * math.Pi
+		}
+		σ := complex(-1, 0)
+		for k := range dst {
+			if cmplx.IsInf(dst[k]) {
+				fill(dst[k:], cmplx.Inf(), nil)
+				return
+			}
+			dst[k] = σ * (fk*dst[k] + fi*i[k])
+			σ = -σ
+		}
+		return
+	}
+	e := complex(math.Pi/2, 0) / z
+	if kode == 1 {
+		e *= cmplx.Exp(-z)
+	}
+	dst[0] = e
+	if len(dst) > 1 {
+		dst[1] = e * (1 + 1/z)
+	}
+	recur(z, -1, dst)
+}
+
+// sphh1 computes h1(k,z), k = 0, ..., len(dst)-1, optionally scaled by e^(-iz)
+func sphh1(z complex128, kode int, dst []complex128) {
+	sphh(z, kode, 1, dst)
+}
+
+// sphh2 computes h2(k,z), k = 0, ..., len(dst)-1, optionally scaled by e^(iz)
+func sphh2(z complex128, kode int, dst []complex128) {
+	sphh(z, kode, 2, dst)
+}
+
+// sphh computes the hankel functions of kind m from
+//    h1(n,z) = -(2/π) i^(-n) k(n,-iz)
+//    h2(n,z) = -(2/π) i^n k(n,iz)
+// or from j ± iy for real z, where j may be much smaller than y
+func sphh(z complex128, kode int, m int, dst []complex128) {
+	if z == 0 {
+		fill(dst, cmplx.Inf(), nil)
+		return
+	}
+	σ := complex(float64(3-2*m), 0)
+	if imag(z) == 0 {
+		y := make([]complex128, len(dst))
+		sphj(z, kode, dst)
+		sphy(z, kode, y)
+		f := complex(1, 0)
+		if kode == 2 {
+			f = cmplx.Exp(-1i * σ * z)
+		}
+		for k := range dst {
+			dst[k] = f * (dst[k] + 1i*σ*y[k])
+		}
+		return
+	}
+	sphk(-1i*σ*z, kode, dst)
+	f := complex(-2/math.Pi, 0)
+	for k := range dst {
+		dst[k] *= f
+		f *= -1i * σ
+	}
+}
+
+// recur completes dst by forward recurrence from dst[0] and dst[1] using
+//    f(k+1) = (2k+1)/z f(k) - σ f(k-1)
+// where σ = 1 for j, y and the hankel functions and σ = -1 for k
+func recur(z complex128, σ complex128, dst []complex128) {
+	for k := 1; k < len(dst)-1; k++ {
+		dst[k+1] = complex(float64(2*k+1), 0)/z*dst[k] - σ*dst[k-1]
+		if cmplx.IsInf(dst[k+1]) || cmplx.IsNaN(dst[k+1]) {
+			fill(dst[k+1:], cmplx.Inf(), nil)
+			return
+		}
+	}
+}
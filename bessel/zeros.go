@@ -0,0 +1,190 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bessel
+
+import (
+	"math"
+)
+
+// The zeros are found by Newton's method from McMahon's expansion for orders below 1,
+// and from the leading term of the uniform asymptotic expansion in terms of the zeros of
+// the Airy functions for higher orders. See Abramowitz and Stegun 9.5.12, 9.5.13 and DLMF 10.21.
+
+// The kinds of zero
+const (
+	zeroJ = iota
+	zeroY
+	zeroJd
+	zeroYd
+)
+
+const machineEpsilon = 2.220446049250313e-16
+
+// JZero computes the k-th positive zero j(ν,k) of the bessel function J(ν,x) for ν ≥ 0
+func JZero(ν float64, k int) float64 {
+	return besselZero(ν, k, zeroJ)
+}
+
+// JZeros computes the first n positive zeros of the bessel function J(ν,x) for ν ≥ 0
+func JZeros(ν float64, n int) []float64 {
+	return besselZeros(ν, n, zeroJ)
+}
+
+// YZero computes the k-th positive zero y(ν,k) of the bessel function Y(ν,x) for ν ≥ 0
+func YZero(ν float64, k int) float64 {
+	return besselZero(ν, k, zeroY)
+}
+
+// YZeros computes the first n positive zeros of the bessel function Y(ν,x) for ν ≥ 0
+func YZeros(ν float64, n int) []float64 {
+	return besselZeros(ν, n, zeroY)
+}
+
+// JdZero computes the k-th positive zero j'(ν,k) of the derivative of the bessel function J(ν,x) for ν ≥ 0.
+// The zero at x = 0 of the derivative of J(0,x) is not counted.
+func JdZero(ν float64, k int) float64 {
+	return besselZero(ν, k, zeroJd)
+}
+
+// JdZeros computes the first n positive zeros of the derivative of the bessel function J(ν,x) for ν ≥ 0
+func JdZeros(ν float64, n int) []float64 {
+	return besselZeros(ν, n, zeroJd)
+}
+
+// YdZero computes the k-th positive zero y'(ν,k) of the derivative of the bessel function Y(ν,x) for ν ≥ 0
+func YdZero(ν float64, k int) float64 {
+	return besselZero(ν, k, zeroYd)
+}
+
+// YdZeros computes the first n positive zeros of the derivative of the bessel function Y(ν,x) for ν ≥ 0
+func YdZeros(ν float64, n int) []float64 {
+	return besselZeros(ν, n, zeroYd)
+}
+
+func besselZeros(ν float64, n int, kind int) []float64 {
+	if n < 0 {
+		panic("n must be non-negative")
+	}
+	zeros := make([]float64, n)
+	for k := range zeros {
+		zeros[k] = besselZero(ν, k+1, kind)
+	}
+	return zeros
+}
+
+func besselZero(ν float64, k int, kind int) float64 {
+	if ν < 0 || math.IsNaN(ν) {
+		panic("order must be non-negative")
+	}
+	if k < 1 {
+		panic("k must be positive")
+	}
+
+	x := zeroGuess(ν, k, kind)
+	var cy [2]complex128
+	for i := 0; i < 100; i++ {
+		// C(ν,x) and C'(ν,x) = (ν/x) C(ν,x) - C(ν+1,x)
+		if kind == zeroJ || kind == zeroJd {
+			zbesj(ν, complex(x, 0), 1, 2, cy[:])
+		} else {
+			zbesy(ν, complex(x, 0), 1, 2, cy[:])
+		}
+		c := real(cy[0])
+		cd := ν/x*c - real(cy[1])
+		var δ float64
+		if kind == zeroJ || kind == zeroY {
+			δ = c / cd
+		} else {
+			// C''(ν,x) = -C'(ν,x)/x - (1 - ν²/x²) C(ν,x)
+			δ = cd / (-cd/x - (1-ν*ν/(x*x))*c)
+		}
+		// the guesses are within a fraction of the spacing of the zeros,
+		// so a larger step is a symptom of a poor guess rather than a solution
+		δ = math.Max(-1, math.Min(1, δ))
+		if δ >= x {
+			δ = x / 2
+		}
+		x -= δ
+		if math.Abs(δ) <= 2*machineEpsilon*x {
+			break
+		}
+	}
+	return x
+}
+
+// zeroGuess approximates the k-th positive zero of the given kind
+func zeroGuess(ν float64, k int, kind int) float64 {
+	if ν >= 1 {
+		// ν z(ζ) with ζ = ν^(-2/3) a, where a is the corresponding zero of Ai, Bi, Ai' or Bi'
+		// and z(ζ) solves (2/3) (-ζ)^(3/2) = √(z²-1) - arcsec(z)
+		var a float64
+		switch kind {
+		case zeroJ:
+			a = airyZeroT(3 * math.Pi / 8 * float64(4*k-1))
+		case zeroY:
+			a = airyZeroT(3 * math.Pi / 8 * float64(4*k-3))
+		case zeroJd:
+			a = airyZeroU(3 * math.Pi / 8 * float64(4*k-3))
+		case zeroYd:
+			a = airyZeroU(3 * math.Pi / 8 * float64(4*k-1))
+		}
+		return ν * uniformZ(2/3.0*math.Pow(a, 1.5)/ν)
+	}
+
+	// McMahon's expansion with μ = 4ν²
+	μ := 4 * ν * ν
+	s := float64(k)
+	switch kind {
+	case zeroJ, zeroY:
+		β := (s + ν/2 - 0.25) * math.Pi
+		if kind == zeroY {
+			β -= math.Pi / 2
+		}
+		p := 8 * β
+		return β - (μ-1)/p - 4*(μ-1)*(7*μ-31)/(3*p*p*p) - 32*(μ-1)*(83*μ*μ-982*μ+3779)/(15*math.Pow(p, 5))
+	}
+	if kind == zeroJd {
+		if ν == 0 {
+			// the first zero of J'(0,x) is at x = 0
+			s++
+		} else if k == 1 {
+			// from the leading terms of the power series of J(ν,x)
+			return math.Sqrt(4 * ν * (ν + 1) / (ν + 2))
+		}
+	}
+	β := (s + ν/2 - 0.75) * math.Pi
+	if kind == zeroYd {
+		β += math.Pi / 2
+	}
+	p := 8 * β
+	return β - (μ+3)/p - 4*(7*μ*μ+82*μ-9)/(3*p*p*p) - 32*(83*μ*μ*μ+2075*μ*μ-3039*μ+3537)/(15*math.Pow(p, 5))
+}
+
+// airyZeroT computes the magnitude of a zero of Ai or Bi from T(t), Abramowitz and Stegun 10.4.105
+func airyZeroT(t float64) float64 {
+	t2 := 1 / (t * t)
+	return math.Pow(t, 2/3.0) * (1 + t2*(5/48.0-t2*(5/36.0-t2*77125/82944.0)))
+}
+
+// airyZeroU computes the magnitude of a zero of Ai' or Bi' from U(t), Abramowitz and Stegun 10.4.106
+func airyZeroU(t float64) float64 {
+	t2 := 1 / (t * t)
+	return math.Pow(t, 2/3.0) * (1 - t2*(7/48.0-t2*(35/288.0-t2*181223/207360.0)))
+}
+
+// uniformZ solves √(z²-1) - arcsec(z) = t for z ≥ 1 by Newton's method, starting
+// from z = t + π/2 which lies to the right of the root of this increasing convex function
+func uniformZ(t float64) float64 {
+	z := t + math.Pi/2
+	for i := 0; i < 100; i++ {
+		w := math.Sqrt(z*z - 1)
+		δ := (w - math.Acos(1/z) - t) * z / w
+		z -= δ
+		if math.Abs(δ) <= machineEpsilon*z {
+			break
+		}
+	}
+	return z
+}
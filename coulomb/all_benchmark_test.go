@@ -0,0 +1,51 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coulomb_test
+
+import (
+	. "github.com/dreading/gospecfunc/coulomb"
+	"testing"
+)
+
+// Global exported variables are used to store the
+// return values of functions measured in the benchmarks.
+// Storing the results in these variables prevents the compiler
+// from completely optimizing the benchmarked functions away.
+var (
+	GlobalF float64
+)
+
+func BenchmarkF(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = F(3, 2, 10)
+	}
+	GlobalF = r
+}
+
+func BenchmarkGInsideTurningPoint(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = G(5, 10, 3)
+	}
+	GlobalF = r
+}
+
+func BenchmarkSeq(b *testing.B) {
+	f, g := make([]float64, 20), make([]float64, 20)
+	fd, gd := make([]float64, 20), make([]float64, 20)
+	for n := 0; n < b.N; n++ {
+		Seq(0, 20, 2, 10, f, g, fd, gd)
+	}
+	GlobalF = f[19]
+}
+
+func BenchmarkPhase(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = Phase(3, 2)
+	}
+	GlobalF = r
+}
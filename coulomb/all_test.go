@@ -0,0 +1,195 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coulomb_test
+
+import (
+	"github.com/dreading/gospecfunc/bessel"
+	. "github.com/dreading/gospecfunc/coulomb"
+	"math"
+	"testing"
+)
+
+func TestCoulomb(t *testing.T) {
+	testCases := []struct {
+		L               int
+		η, ρ            float64
+		f, g, fd, gd, σ float64
+	}{
+		// extended precision values computed from the power series and the asymptotic expansion
+		{0, 0.5, 0.5, 2.30390681341676922811e-01, 1.51667394999526505828e+00, 5.32652516609938397885e-01, -8.33973850588760057256e-01, -2.44058298905427767389e-01},
+		{0, -5.0, 0.5, -2.90340668842144211670e-01, -3.71171098733248383539e-01, -1.80472423494898648322e+00, 1.13707295681321562952e+00, -3.81589857461492432122e+00},
+		{0, 2.0, 3.0, 3.98612760837392277047e-01, 2.04054153346189703910e+00, 3.26953758801320382155e-01, -8.34989012758169502071e-01, 1.29646316309788317556e-01},
+		{0, -5.0, 3.0, -6.69377889589190866459e-01, -1.83129949042509648782e-01, -4.22808819180127193338e-01, 1.37825144337381377824e+00, -3.81589857461492432122e+00},
+		{0, 0.5, 30.0, 5.36992592902976406677e-01, -8.53653297780835318420e-01, -8.39467656370326054116e-01, -5.27727329025419189534e-01, -2.44058298905427767389e-01},
+		{0, 10.0, 30.0, -1.28689904735409599290e+00, 2.64484737026442262575e-01, 1.74075659995821585557e-01, 7.41285531918506768179e-01, 1.38029129742299012662e+01},
+		{0, -5.0, 30.0, 7.53724145288410946053e-02, -9.27568006371533915200e-01, -1.07085938144459502652e+00, -8.89595814476294849404e-02, -3.81589857461492432122e+00},
+		{0, 2.0, 0.5, 7.67341654718882784647e-03, 2.33026482803703878233e+01, 2.63471824108423048616e-02, -5.03088647315526600323e+01, 1.29646316309788317556e-01},
+		{5, 0.5, 0.5, 6.91792680219216265981e-07, 6.54402690836052788654e+04, 8.33249385993012615080e-06, -6.57305826833252562210e+05, 8.53740297674792758542e-01},
+		{5, -5.0, 3.0, 8.40507162005205255007e-01, 4.42205759646699392285e-01, 3.69990246892985874538e-01, -9.95099410950215701455e-01, -9.09141966573557702702e+00},
+		{5, 2.0, 3.0, 4.03337963621398006703e-03, 6.47045756044024642506e+01, 8.46783985406633805948e-03, -1.12087642803928673629e+02, 3.45434978716206586569e+00},
+		{5, 10.0, 30.0, -1.11617089640443589715e+00, -7.55264201588463968129e-01, -3.93990512303780016090e-01, 6.29323943631060700454e-01, 2.02242196152723039404e+01},
+		{5, -5.0, 30.0, 1.66985710291304934039e-01, -9.21521823232974601758e-01, -1.05037692070373078757e+00, -1.91961006096517172725e-01, -9.09141966573557702702e+00},
+		{5, 0.5, 100.0, 5.69750019977116939351e-01, -8.25801725247147122566e-01, -8.20439943176585417817e-01, -5.66001348233487133754e-01, 8.53740297674792758542e-01},
+		{10, 0.0, 3.0, 1.05780116795257693370e-05, 1.40995775664341726952e+04, 3.73840579871390567995e-05, -4.47059985363393789157e+04, 0.00000000000000000000e+00},
+		{10, 2.0, 30.0, 2.56749711749085451906e-01, 1.04533511186113403646e+00, 9.01125046200633605942e-01, -2.25987981188835090895e-01, 4.71544316941450603053e+00},
+		{10, -5.0, 10.0, -3.46464959635884028888e-02, -1.02182332624228533469e+00, -9.76471446695347133904e-01, 6.40381745812236558013e-02, -1.19357528566518062974e+01},
+		{20, 0.5, 3.0, 3.49654333299107400557e-16, 2.10781151711846468750e+14, 2.43146258449036252543e-15, -1.39421557141074625000e+15, 1.51031153151060260065e+00},
+		{20, 2.0, 30.0, -1.04861941629700039513e+00, -6.88063178936794805907e-01, -4.15066753448217806710e-01, 6.81284209550724884075e-01, 6.04420981342663132807e+00},
+		{20, -5.0, 30.0, -1.03612060488309887241e+00, 1.04324844710280556503e-02, 1.56419995242347252551e-02, 9.64981113560303138676e-01, -1.51513053366426717616e+01},
+		{20, 10.0, 100.0, -1.04206652290176382536e+00, 2.50337237213260233037e-01, 2.18931003968215270872e-01, 9.07037503416061152706e-01, 3.05761500543011131015e+01},
+		{1, 50.0, 20.0, 6.57513862906767160881e-32, 3.79966446707068440061e+30, 1.32618799527506946082e-31, -7.54498251301669193685e+30, 1.47935680738735072737e+02},
+		{1, 50.0, 120.0, 1.87610304881773076735e-01, 1.55250422669593279856e+00, 6.32920402909952994897e-01, -9.26836046194952772881e-02, 1.47935680738735072737e+02},
+		{2, -50.0, 5.0, 2.67261843675107912954e-01, 3.84820081792325130454e-01, 1.76560377666635326399e+00, -1.19942377797894383917e+00, -1.49466498378406669190e+02},
+		{0, 0.001, 0.01, 9.98422959360643035021e-03, 1.00143594411475422845e+00, 9.98399662736175419475e-01, -1.66954437879415391877e-02, -5.77215264216105871514e-04},
+		{3, 0.1, 1000.0, 9.41099529554883851290e-01, -3.38286405682397206540e-01, -3.38250598155329373906e-01, -9.40999748824618897913e-01, 1.25625103674632282447e-01},
+	}
+
+	for _, tc := range testCases {
+		if f := F(tc.L, tc.η, tc.ρ); !soclose(f, tc.f, 1e-13) {
+			t.Errorf("F(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, tc.f, f)
+		}
+		if g := G(tc.L, tc.η, tc.ρ); !soclose(g, tc.g, 1e-13) {
+			t.Errorf("G(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, tc.g, g)
+		}
+		if fd := Fd(tc.L, tc.η, tc.ρ); !soclose(fd, tc.fd, 1e-13) {
+			t.Errorf("Fd(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, tc.fd, fd)
+		}
+		// G' is found from G and F by the Wronskian, which cancels for small ρ
+		if gd := Gd(tc.L, tc.η, tc.ρ); !soclose(gd, tc.gd, 1e-11) {
+			t.Errorf("Gd(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, tc.gd, gd)
+		}
+		if σ := Phase(tc.L, tc.η); !soclose(σ, tc.σ, 1e-14) {
+			t.Errorf("Phase(%v, %v): expected %v, got %v", tc.L, tc.η, tc.σ, σ)
+		}
+	}
+}
+
+func TestRiccati(t *testing.T) {
+	// F(L,0,ρ) = ρ j(L,ρ) and G(L,0,ρ) = -ρ y(L,ρ)
+	for _, L := range []int{0, 1, 3, 10, 40} {
+		for _, ρ := range []float64{1e-6, 1e-3, 0.1, 3, 30, 300} {
+			want := real(bessel.RiccatiPsi(L, complex(ρ, 0)))
+			if f := F(L, 0, ρ); !soclose(f, want, 1e-12) {
+				t.Errorf("F(%v, 0, %v): expected %v, got %v", L, ρ, want, f)
+			}
+			want = real(bessel.RiccatiChi(L, complex(ρ, 0)))
+			if g := G(L, 0, ρ); !soclose(g, want, 1e-12) {
+				t.Errorf("G(%v, 0, %v): expected %v, got %v", L, ρ, want, g)
+			}
+		}
+	}
+}
+
+func TestSeq(t *testing.T) {
+	const m = 12
+	f, g := make([]float64, m), make([]float64, m)
+	fd, gd := make([]float64, m), make([]float64, m)
+	for _, tc := range []struct{ L, η, ρ float64 }{{0, 0.5, 3}, {2, -5, 10}, {5, 10, 30}, {1, 50, 20}} {
+		L := int(tc.L)
+		Seq(L, m, tc.η, tc.ρ, f, g, fd, gd)
+		for k := 0; k < m; k++ {
+			if want := F(L+k, tc.η, tc.ρ); !soclose(f[k], want, 1e-13) {
+				t.Errorf("Seq(%v, %v, %v): expected F %v at order %v, got %v", L, tc.η, tc.ρ, want, L+k, f[k])
+			}
+			if want := G(L+k, tc.η, tc.ρ); !soclose(g[k], want, 1e-13) {
+				t.Errorf("Seq(%v, %v, %v): expected G %v at order %v, got %v", L, tc.η, tc.ρ, want, L+k, g[k])
+			}
+			// the Wronskian F'G - FG' = 1
+			if w := fd[k]*g[k] - f[k]*gd[k]; math.Abs(w-1) > 1e-13 {
+				t.Errorf("Seq(%v, %v, %v): expected a Wronskian of 1 at order %v, got %v", L, tc.η, tc.ρ, L+k, w)
+			}
+		}
+	}
+}
+
+func TestAttractive(t *testing.T) {
+	// inside the turning point of an attractive field F is normalised at order 0
+	testCases := []struct {
+		L    int
+		η, ρ float64
+		f    float64
+	}{
+		// extended precision values computed from the power series
+		{19, -20, 1, 6.39524053719017763697e-15},
+		{24, -50, 0.5, 7.88890481586359834939e-22},
+		{24, -5, 5, 2.23854472885926821176e-12},
+		{39, -2, 20, 8.27001099385562204998e-8},
+		{39, -50, 10, 3.09106418738022202836e-3},
+		{60, -1, 40, 1.48119919083802188345e-6},
+		{60, -5, 40, 3.51860131161890183307e-5},
+		{60, -50, 20, 5.87438566190130979277e-5},
+	}
+
+	for _, tc := range testCases {
+		if f := F(tc.L, tc.η, tc.ρ); !soclose(f, tc.f, 1e-13) {
+			t.Errorf("F(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, tc.f, f)
+		}
+		m := tc.L + 1
+		f, g := make([]float64, m), make([]float64, m)
+		fd, gd := make([]float64, m), make([]float64, m)
+		Seq(0, m, tc.η, tc.ρ, f, g, fd, gd)
+		if !soclose(f[tc.L], tc.f, 1e-13) {
+			t.Errorf("Seq(0, %v, %v): expected F %v at order %v, got %v", tc.η, tc.ρ, tc.f, tc.L, f[tc.L])
+		}
+		if want, g := g[tc.L], G(tc.L, tc.η, tc.ρ); !soclose(g, want, 1e-13) {
+			t.Errorf("G(%v, %v, %v): expected %v, got %v", tc.L, tc.η, tc.ρ, want, g)
+		}
+	}
+}
+
+func TestSpecialCases(t *testing.T) {
+	if σ := Phase(7, 0); σ != 0 {
+		t.Errorf("Phase(7, 0): expected 0, got %v", σ)
+	}
+	for _, tc := range []struct{ η, ρ float64 }{{1, 0}, {1, -1}, {math.NaN(), 1}, {1, math.Inf(1)}} {
+		if f := F(0, tc.η, tc.ρ); !math.IsNaN(f) {
+			t.Errorf("F(0, %v, %v): expected NaN, got %v", tc.η, tc.ρ, f)
+		}
+	}
+}
+
+func TestPanic(t *testing.T) {
+	v := make([]float64, 4)
+	for _, f := range []func(){
+		func() { F(-1, 1, 1) },
+		func() { Phase(-1, 1) },
+		func() { Seq(0, 0, 1, 1, v, v, v, v) },
+		func() { Seq(0, 5, 1, 1, v, v, v, v) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("the function did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+// The floating point comparison tests are copied from from math/all_test.go.
+func tolerance(a, b, e float64) bool {
+	// Multiplying by e here can underflow denormal values to zero.
+	// Check a==b so that at least if a and b are small and identical
+	// we say they match.
+	if a == b {
+		return true
+	}
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	// note: b is correct (expected) value, a is actual value.
+	// make error tolerance a fraction of b, not a.
+	if b != 0 {
+		e = e * b
+		if e < 0 {
+			e = -e
+		}
+	}
+	return d < e
+}
+
+func soclose(a, b, e float64) bool { return tolerance(a, b, e) }
@@ -0,0 +1,358 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package coulomb
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The regular and irregular Coulomb wave functions F(L,η,ρ) and G(L,η,ρ) are the solutions of
+//    u'' + (1 - 2η/ρ - L(L+1)/ρ²) u = 0
+// with F ~ sin(θ) and G ~ cos(θ) as ρ → ∞, θ = ρ - η log(2ρ) - Lπ/2 + σ(L,η), where
+// σ(L,η) = arg Γ(L+1+iη) is the Coulomb phase shift. For η = 0 they reduce to the
+// Riccati-Bessel functions F(L,0,ρ) = ρ j(L,ρ) and G(L,0,ρ) = -ρ y(L,ρ).
+//
+// They are computed by Steed's method. With R(L) = √(1 + η²/L²) and S(L) = L/ρ + η/L, both F
+// and G satisfy the recurrences
+//    u(L-1) = (S(L) u(L) + u'(L)) / R(L)
+//    u'(L) = R(L) u(L-1) - S(L) u(L)
+// The continued fraction CF1
+//    F'(L)/F(L) = S(L+1) - R(L+1)²/(S(L+1) + S(L+2) - R(L+2)²/(S(L+2) + S(L+3) - ...))
+// gives F' relative to F at the highest order, from which F and F' of the lower orders follow
+// by the downward recurrence, in which F is the dominant solution. The continued fraction CF2
+//    p + iq = H'/H = i(1 - η/ρ) + (i/ρ) ab/(2(ρ-η+i) + (a+1)(b+1)/(2(ρ-η+2i) + ...))
+// for H = G + iF, a = iη - L and b = iη + L + 1, together with the Wronskian F'G - FG' = 1,
+// then fixes the normalisation of F and gives G and G' at the lowest order, from which the
+// higher orders follow by the upward recurrence, in which G is the dominant solution. CF1
+// needs of order ρ terms, and CF2 converges slowly for ρ far inside the turning point
+// η + √(η² + L(L+1)). Inside the turning point, where CF2 also loses digits, F is normalised
+// by its power series, or for η < 0 at order 0, which lies outside its turning point and to
+// which the downward recurrence is continued. See A. R. Barnett, The calculation of spherical Bessel and Coulomb
+// functions, in Computational Atomic and Nuclear Physics, World Scientific (1990), and
+// I. J. Thompson and A. R. Barnett, Coulomb and Bessel functions of complex arguments and
+// order, J. Comput. Phys. 64 (1986) 490-509.
+
+// The largest number of terms of the continued fractions
+const maxTerms = 1000000
+
+// F computes the regular Coulomb wave function F(L,η,ρ) for L ≥ 0 and ρ > 0
+func F(L int, η float64, ρ float64) float64 {
+	f, _, _, _ := single(L, η, ρ)
+	return f
+}
+
+// G computes the irregular Coulomb wave function G(L,η,ρ) for L ≥ 0 and ρ > 0
+func G(L int, η float64, ρ float64) float64 {
+	_, g, _, _ := single(L, η, ρ)
+	return g
+}
+
+// Fd computes the derivative dF(L,η,ρ)/dρ of the regular Coulomb wave function for L ≥ 0 and ρ > 0
+func Fd(L int, η float64, ρ float64) float64 {
+	_, _, fd, _ := single(L, η, ρ)
+	return fd
+}
+
+// Gd computes the derivative dG(L,η,ρ)/dρ of the irregular Coulomb wave function for L ≥ 0 and ρ > 0
+func Gd(L int, η float64, ρ float64) float64 {
+	_, _, _, gd := single(L, η, ρ)
+	return gd
+}
+
+// Seq computes the m member sequences of the Coulomb wave functions F, G and their derivatives
+// F' and G' of orders L, L+1, ..., L+m-1 into f, g, fd and gd. The continued fractions need of
+// order ρ terms, and where they do not converge, for ρ of about 10⁶ or more, the results are NaN.
+func Seq(L int, m int, η float64, ρ float64, f []float64, g []float64, fd []float64, gd []float64) {
+	if L < 0 {
+		panic("order must be non-negative")
+	}
+	if m < 1 {
+		panic("m must be positive")
+	}
+	if len(f) < m || len(g) < m || len(fd) < m || len(gd) < m {
+		panic("dst is too short")
+	}
+	steed(L, η, ρ, f[:m], g[:m], fd[:m], gd[:m])
+}
+
+// Phase computes the Coulomb phase shift σ(L,η) = arg Γ(L+1+iη) for L ≥ 0, continuous in η
+// with σ(L,0) = 0
+func Phase(L int, η float64) float64 {
+	if L < 0 {
+		panic("order must be non-negative")
+	}
+	if math.IsNaN(η) || math.IsInf(η, 0) {
+		return math.NaN()
+	}
+	_, σ := logGamma(L, η)
+	return σ
+}
+
+// logGamma computes log Γ(L+1+iη) from Stirling's series after the recurrence
+// Γ(z+1) = z Γ(z), whose phases are summed rather than multiplied so that the imaginary
+// part is not reduced modulo 2π
+func logGamma(L int, η float64) (float64, float64) {
+	z := complex(float64(L+1), η)
+	var lg, σ float64
+	for cmplx.Abs(z) < 15 {
+		lg -= math.Log(cmplx.Abs(z))
+		σ -= math.Atan2(η, real(z))
+		z++
+	}
+	w := 1 / (z * z)
+	s := complex(0, 0)
+	for k := len(stirling) - 1; k >= 0; k-- {
+		s = s*w + complex(stirling[k], 0)
+	}
+	// (z-½) log(z) - z with log|z| and arg(z) kept apart for large |η|
+	x, r, θ := real(z), math.Log(cmplx.Abs(z)), cmplx.Phase(z)
+	s /= z
+	lg += (x-0.5)*r - η*θ - x + math.Log(2*math.Pi)/2 + real(s)
+	σ += (x-0.5)*θ + η*r - η + imag(s)
+	return lg, σ
+}
+
+// Stirling's series log Γ(z) ~ (z-½) log(z) - z + log(2π)/2 + Σ B(2k) / (2k(2k-1) z^(2k-1))
+var stirling = []float64{
+	1.0 / 12,
+	-1.0 / 360,
+	1.0 / 1260,
+	-1.0 / 1680,
+	1.0 / 1188,
+	-691.0 / 360360,
+	1.0 / 156,
+	-3617.0 / 122400,
+}
+
+// single computes F, G, F' and G' of order L
+func single(L int, η float64, ρ float64) (float64, float64, float64, float64) {
+	if L < 0 {
+		panic("order must be non-negative")
+	}
+	var f, g, fd, gd [1]float64
+	steed(L, η, ρ, f[:], g[:], fd[:], gd[:])
+	return f[0], g[0], fd[0], gd[0]
+}
+
+// steed computes F, G, F' and G' of orders L, ..., L+len(f)-1 by Steed's method
+func steed(L int, η float64, ρ float64, f []float64, g []float64, fd []float64, gd []float64) {
+	m := len(f)
+	fail := func() {
+		for k := 0; k < m; k++ {
+			f[k], g[k], fd[k], gd[k] = math.NaN(), math.NaN(), math.NaN(), math.NaN()
+		}
+	}
+	if ρ <= 0 || math.IsNaN(ρ) || math.IsNaN(η) || math.IsInf(ρ, 0) || math.IsInf(η, 0) {
+		fail()
+		return
+	}
+
+	R := func(l int) float64 {
+		e := η / float64(l)
+		return math.Sqrt(1 + e*e)
+	}
+	S := func(l int) float64 {
+		return float64(l)/ρ + η/float64(l)
+	}
+
+	// CF1 for F'/F at the highest order by the modified Lentz method. The sign of F there
+	// is the product of the signs of the ratios of successive orders, which are those of D.
+	const tiny = 1e-300
+	top := L + m - 1
+	l := top + 1
+	h := S(l)
+	if h == 0 {
+		h = tiny
+	}
+	c, d := h, 0.0
+	sign := 1.0
+	converged := false
+	for k := 0; k < maxTerms; k++ {
+		r := R(l)
+		a, b := -r*r, S(l)+S(l+1)
+		l++
+		d = b + a*d
+		if d == 0 {
+			d = tiny
+		}
+		c = b + a/c
+		if c == 0 {
+			c = tiny
+		}
+		d = 1 / d
+		δ := c * d
+		h *= δ
+		if d < 0 {
+			sign = -sign
+		}
+		if math.Abs(δ-1) < machineEpsilon {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		fail()
+		return
+	}
+
+	// the downward recurrence from F = ±1, rescaling the stored values against overflow
+	const big = 1e250
+	u, ud := sign, h*sign
+	f[m-1], fd[m-1] = u, ud
+	for l := top; l > L; l-- {
+		r, s := R(l), S(l)
+		v := u
+		u = (s*v + ud) / r
+		ud = s*u - r*v
+		if math.Abs(u) > big {
+			for k := l - L; k < m; k++ {
+				f[k] /= big
+				fd[k] /= big
+			}
+			u /= big
+			ud /= big
+		}
+		f[l-1-L], fd[l-1-L] = u, ud
+	}
+
+	F0, G0, Gd0, lost, ok := normalise(L, η, ρ, f[0], fd[0])
+	if !ok {
+		fail()
+		return
+	}
+	if lost > 100 && η < 0 && L > 0 {
+		// Inside the turning point of an attractive field both normalisations lose digits,
+		// but order 0 lies outside its turning point. The downward recurrence for F is
+		// continued to order 0, normalised there, and G is found by the upward recurrence.
+		for l := L; l > 0; l-- {
+			r, s := R(l), S(l)
+			v := u
+			u = (s*v + ud) / r
+			ud = s*u - r*v
+			if math.Abs(u) > big {
+				for k := 0; k < m; k++ {
+					f[k] /= big
+					fd[k] /= big
+				}
+				u /= big
+				ud /= big
+			}
+		}
+		var F00, G00, Gd00 float64
+		F00, G00, Gd00, _, ok = normalise(0, η, ρ, u, ud)
+		if !ok {
+			fail()
+			return
+		}
+		F0 = f[0] * (F00 / u)
+		G0, Gd0 = G00, Gd00
+		for l := 1; l <= L; l++ {
+			r, s := R(l), S(l)
+			v := G0
+			G0 = (s*v - Gd0) / r
+			Gd0 = r*v - s*G0
+		}
+	}
+	scale := F0 / f[0]
+	for k := 0; k < m; k++ {
+		f[k] *= scale
+		fd[k] *= scale
+	}
+
+	// the upward recurrence for G
+	g[0], gd[0] = G0, Gd0
+	for k := 1; k < m; k++ {
+		r, s := R(L+k), S(L+k)
+		g[k] = (s*g[k-1] - gd[k-1]) / r
+		gd[k] = r*g[k-1] - s*g[k]
+	}
+}
+
+// normalise computes F, G and G' of order L from w'/w = F'/F by CF2 for p + iq = H'/H,
+// evaluating the tail b(1) + a(2)/(b(2) + ...) first, which is not needed when a(1) = 0, and
+// the Wronskian F'G - FG' = 1, which with G = γF and G' = pG - qF gives F² q (1 + γ²) = 1. Inside
+// the turning point, where F is small, q = 1/(F² + G²) is found with cancellation in CF2, and F
+// is instead normalised by its power series where that has less cancellation. The estimate of
+// the digits lost, as a factor, is returned with the values.
+func normalise(L int, η float64, ρ float64, w float64, wd float64) (float64, float64, float64, float64, bool) {
+	const tiny = 1e-300
+	a, b := complex(-float64(L), η), complex(float64(L+1), η)
+	hc := complex(2*(ρ-η), 2)
+	cc, dc := hc, complex(0, 0)
+	converged := a*b == 0
+	for k := 2; k < maxTerms && !converged; k++ {
+		ak := (a + complex(float64(k-1), 0)) * (b + complex(float64(k-1), 0))
+		bk := complex(2*(ρ-η), 2*float64(k))
+		dc = bk + ak*dc
+		if dc == 0 {
+			dc = tiny
+		}
+		cc = bk + ak/cc
+		if cc == 0 {
+			cc = tiny
+		}
+		dc = 1 / dc
+		δ := cc * dc
+		hc *= δ
+		if cmplx.Abs(δ-1) < machineEpsilon {
+			converged = true
+			break
+		}
+	}
+	if !converged {
+		return 0, 0, 0, 0, false
+	}
+	hc = a * b / hc
+	pq := complex(0, 1-η/ρ) + complex(0, 1/ρ)*hc
+	p, q := real(pq), imag(pq)
+
+	loss := math.Max(math.Abs(1-η/ρ), math.Abs(imag(hc))/ρ) / math.Abs(q)
+	if F, lost := coulombSeries(L, η, ρ); lost < loss {
+		Fd := wd / w * F
+		G := (1 - q*F*F) / (Fd - p*F)
+		return F, G, p*G - q*F, lost, true
+	}
+	γ := (wd/w - p) / q
+	F := math.Copysign(1/(math.Sqrt(q)*math.Hypot(1, γ)), w)
+	G := γ * F
+	return F, G, p*G - q*F, loss, true
+}
+
+// coulombSeries computes F(L,η,ρ) from the power series
+//
+//    F = C(L,η) ρ^(L+1) Σ a(k) ρ^k
+//    C(L,η) = 2^L exp(-πη/2) |Γ(L+1+iη)| / (2L+1)!
+//
+// with a(0) = 1, a(1) = η/(L+1) and k(2L+1+k) a(k) = 2η a(k-1) - a(k-2), together with the
+// digits lost to cancellation in the sum, as the ratio of its largest term to the sum
+func coulombSeries(L int, η float64, ρ float64) (float64, float64) {
+	const big = 1e250
+	l := float64(L)
+	prev, term := 0.0, 1.0
+	sum, max := 1.0, 1.0
+	var e float64 // the sum and terms are scaled by exp(-e)
+	for k := 1; k < maxTerms; k++ {
+		kk := float64(k)
+		prev, term = term, (2*η*ρ*term-ρ*ρ*prev)/(kk*(2*l+1+kk))
+		sum += term
+		max = math.Max(max, math.Abs(term))
+		if math.Abs(term) <= machineEpsilon*math.Abs(sum)/4 && math.Abs(prev) <= machineEpsilon*math.Abs(sum)/4 {
+			break
+		}
+		if max > big {
+			prev, term, sum, max = prev/big, term/big, sum/big, max/big
+			e += math.Log(big)
+		}
+	}
+	lgΓ, _ := logGamma(L, η)
+	lg2L, _ := math.Lgamma(2*l + 2)
+	logC := l*math.Ln2 - math.Pi*η/2 + lgΓ - lg2L
+	// the partial sums may pass through zero, so only the digits lost in the whole sum count
+	return math.Copysign(math.Exp(logC+(l+1)*math.Log(ρ)+e+math.Log(math.Abs(sum))), sum), max / math.Abs(sum)
+}
+
+// machineEpsilon is the relative spacing of the floating point numbers near 1
+const machineEpsilon = 2.220446049250313e-16
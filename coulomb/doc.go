@@ -0,0 +1,6 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package coulomb provides the Coulomb wave functions and the Coulomb phase shift
+package coulomb
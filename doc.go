@@ -6,6 +6,7 @@
 // numeric and scientific algorithms easy by providing accurate performant
 // algorithms that can scale for any application.
 //
-// Gonum contains libraries for bessel functions, error functions
-// and integral of special functions.
+// Gonum contains libraries for bessel functions, error functions,
+// integral of special functions, hankel transforms, Coulomb
+// wave functions and the normal distribution.
 package gospecfunc // import "github.com/dreading/gospecfunc"
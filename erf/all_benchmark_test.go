@@ -6,6 +6,7 @@ package erf_test
 
 import (
 	. "github.com/dreading/gospecfunc/erf"
+	"math"
 	"testing"
 )
 
@@ -121,3 +122,115 @@ func BenchmarkVoigt(b *testing.B) {
 	}
 	GlobalF = r
 }
+
+func BenchmarkErfReal(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfReal(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkMathErf(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = math.Erf(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfRealSmall(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfReal(0.2)
+	}
+	GlobalF = r
+}
+
+func BenchmarkMathErfSmall(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = math.Erf(0.2)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfcReal(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfcReal(4.5)
+	}
+	GlobalF = r
+}
+
+func BenchmarkMathErfc(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = math.Erfc(4.5)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfcxRealFloat(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfcxReal(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfiReal(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfiReal(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkDawsonReal(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = DawsonReal(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkDawsonRealLarge(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = DawsonReal(10)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfInv(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfInv(0.3)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfcInv(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfcInv(1e-10)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfcxInv(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = ErfcxInv(0.5)
+	}
+	GlobalF = r
+}
+
+func BenchmarkErfInvComplex(b *testing.B) {
+	var r complex128
+	for n := 0; n < b.N; n++ {
+		r = ErfInvComplex(complex(0.5, 0.5))
+	}
+	GlobalC = r
+}
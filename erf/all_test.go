@@ -6,6 +6,7 @@ package erf_test
 
 import (
 	. "github.com/dreading/gospecfunc/erf"
+	"math"
 	"math/cmplx"
 	"testing"
 )
@@ -67,6 +68,98 @@ func TestErfc(t *testing.T) {
 	}
 }
 
+func TestErfRelativeAccuracy(t *testing.T) {
+	// both parts keep full relative accuracy near the origin and near the real and imaginary axes
+	testCases := []struct {
+		x, y complex128
+	}{
+		// extended precision values
+		{complex(1e-10, 1e-10), complex(1.1283791670955126150130139e-10, 1.1283791670955126149979688e-10)},
+		{complex(1e-300, 2e-300), complex(1.1283791670955126021723161e-300, 2.2567583341910252043446322e-300)},
+		{complex(0.001, -0.002), complex(0.0011283833044904183014042646, -0.0022567590864395154101418719)},
+		{complex(-0.3, 0.2), complex(-0.34123748147213858587929147, 0.20852883788276887637500898)},
+		{complex(0.3, 1e-12), complex(0.32862675945912741618961829, 1.0312609096189630364255854e-12)},
+		{complex(2, 1e-10), complex(0.99532226501895273416248260, 2.0666985354092054609530400e-12)},
+		{complex(-6, 1e-3), complex(-0.99999999999999997848183365, 2.6172392968681103231992001e-19)},
+		{complex(9, 0.05), complex(1.0000000000000000000000000, 3.2628132646972678548243508e-37)},
+		{complex(1e-10, 2), complex(6.1607415059355130964086443e-9, 18.564802414575552597472144)},
+		{complex(-1e-6, 3.5), complex(-0.23581013267134902703718190, 35282.287714346349845805043)},
+		{complex(0.01, -1), complex(0.030669458796940225574505267, -1.6501190590980992470466500)},
+		{complex(0.5, 0.5), complex(0.64261291485482052831942136, 0.45788139443519221584208890)},
+		{complex(-1.5, 2.5), complex(-7.2546886934779263445562976, 8.7859672933704554608024585)},
+	}
+
+	for _, tc := range testCases {
+		y := Erf(tc.x)
+		if soclose(real(y), real(tc.y), 4e-15) == false {
+			t.Fatalf("real(Erf(%v)): expected %v, got %v", tc.x, real(tc.y), real(y))
+		}
+		if soclose(imag(y), imag(tc.y), 4e-15) == false {
+			t.Fatalf("imag(Erf(%v)): expected %v, got %v", tc.x, imag(tc.y), imag(y))
+		}
+	}
+}
+
+func TestErfcRelativeAccuracy(t *testing.T) {
+	// both parts keep full relative accuracy near the origin and near the real and imaginary axes
+	testCases := []struct {
+		x, y complex128
+	}{
+		// extended precision values
+		{complex(1e-10, 1e-10), complex(0.99999999988716208329044874, -1.1283791670955126149979688e-10)},
+		{complex(0.001, -0.002), complex(0.99887161669550958169859574, 0.0022567590864395154101418719)},
+		{complex(-0.3, 0.2), complex(1.3412374814721385858792915, -0.20852883788276887637500898)},
+		{complex(2, 1e-10), complex(0.0046777349810472658375174039, -2.0666985354092054609530400e-12)},
+		{complex(5, 1e-8), complex(1.5374597944280270147550780e-12, -1.5670866531017310040369359e-19)},
+		{complex(-6, 1e-3), complex(1.9999999999999999784818337, -2.6172392968681103231992001e-19)},
+		{complex(9, 0.05), complex(2.5601481522182672425144875e-37, -3.2628132646972678548243508e-37)},
+		{complex(1e-10, 2), complex(0.99999999383925849406448690, -18.564802414575552597472144)},
+		{complex(-1e-6, 3.5), complex(1.2358101326713490270371819, -35282.287714346349845805043)},
+		{complex(0.5, 0.5), complex(0.35738708514517947168057864, -0.45788139443519221584208890)},
+		{complex(3, 4), complex(121.18699139507944409814496, 27.750337293623902498133682)},
+		{complex(-1.5, 2.5), complex(8.2546886934779263445562976, -8.7859672933704554608024585)},
+	}
+
+	for _, tc := range testCases {
+		y := Erfc(tc.x)
+		if soclose(real(y), real(tc.y), 4e-15) == false {
+			t.Fatalf("real(Erfc(%v)): expected %v, got %v", tc.x, real(tc.y), real(y))
+		}
+		if soclose(imag(y), imag(tc.y), 4e-15) == false {
+			t.Fatalf("imag(Erfc(%v)): expected %v, got %v", tc.x, imag(tc.y), imag(y))
+		}
+	}
+}
+
+func TestErfiRelativeAccuracy(t *testing.T) {
+	// both parts keep full relative accuracy near the origin and near the real and imaginary axes
+	testCases := []struct {
+		x, y complex128
+	}{
+		// extended precision values
+		{complex(1e-10, 1e-10), complex(1.1283791670955126149979688e-10, 1.1283791670955126150130139e-10)},
+		{complex(0.001, -0.002), complex(0.0011283750297098596025364438, -0.0022567575819339592977292702)},
+		{complex(0.2, -0.3), complex(0.20852883788276887637500898, -0.34123748147213858587929147)},
+		{complex(1e-10, 2), complex(2.0666985354092054609530400e-12, 0.99532226501895273416248260)},
+		{complex(1e-3, -6), complex(2.6172392968681103231992001e-19, -0.99999999999999997848183365)},
+		{complex(0.05, 9), complex(3.2628132646972678548243508e-37, 1.0000000000000000000000000)},
+		{complex(2, 1e-10), complex(18.564802414575552597472144, 6.1607415059355130964086443e-9)},
+		{complex(3.5, -1e-6), complex(35282.287714346349845805043, -0.23581013267134902703718190)},
+		{complex(0.5, 0.5), complex(0.45788139443519221584208890, 0.64261291485482052831942136)},
+		{complex(2.5, -1.5), complex(8.7859672933704554608024585, -7.2546886934779263445562976)},
+	}
+
+	for _, tc := range testCases {
+		y := Erfi(tc.x)
+		if soclose(real(y), real(tc.y), 4e-15) == false {
+			t.Fatalf("real(Erfi(%v)): expected %v, got %v", tc.x, real(tc.y), real(y))
+		}
+		if soclose(imag(y), imag(tc.y), 4e-15) == false {
+			t.Fatalf("imag(Erfi(%v)): expected %v, got %v", tc.x, imag(tc.y), imag(y))
+		}
+	}
+}
+
 func TestDawson(t *testing.T) {
 	testCases := []struct {
 		x, y complex128
@@ -367,6 +460,367 @@ func TestErfcxInf(t *testing.T) {
 	}
 }
 
+func TestErfReal(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{1e-300, 1.1283791670955126021723161e-300},
+		{-1e-10, -1.1283791670955126150017301e-10},
+		{0.01, 1.1283415555849617150777135e-2},
+		{0.3, 3.2862675945912741618961799e-1},
+		{-0.5, -5.2049987781304653768274665e-1},
+		{0.75, 7.1115563365351513159893783e-1},
+		{1, 8.4270079294971486934122064e-1},
+		{2.5, 9.9959304798255504106043578e-1},
+		{-4, -9.9999998458274209971998115e-1},
+		{6, 9.9999999999999997848026329e-1},
+	}
+
+	for _, tc := range testCases {
+		if y := ErfReal(tc.x); close(y, tc.y) == false {
+			t.Fatalf("ErfReal(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+func TestErfcReal(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-3, 1.9999779095030014145586272e+0},
+		{-1, 1.8427007929497148693412206e+0},
+		{0.01, 9.8871658444415038284922286e-1},
+		{0.5, 4.7950012218695346231725335e-1},
+		{2, 4.6777349810472658379307436e-3},
+		{5, 1.5374597944280348501883435e-12},
+		{10, 2.0884875837625447570007863e-45},
+		{15, 7.2129941724512066665650666e-100},
+		{20, 5.3958656116079009289349992e-176},
+		{26, 5.6631924088561428464757279e-296},
+	}
+
+	for _, tc := range testCases {
+		if y := ErfcReal(tc.x); close(y, tc.y) == false {
+			t.Fatalf("ErfcReal(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+func TestErfcxReal(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-26, 7.6577249314905683515267737e+293},
+		{-10, 5.3762342836322708968252511e+43},
+		{-6.2, 9.8925870383350294194216917e+16},
+		{-3, 1.6205988853999586625469574e+4},
+		{-1, 5.0089800807622834663098246e+0},
+		{-0.1, 1.1236433541992094806637045e+0},
+		{0, 1.0000000000000000000000000e+0},
+		{0.2, 8.0901951990158073282595973e-1},
+		{1, 4.2758357615580700441075034e-1},
+		{3, 1.7900115118138995041929482e-1},
+		{8, 6.9985166200880927722752249e-2},
+		{30, 1.8795888861416751497125329e-2},
+		{100, 5.6416137829894329035564570e-3},
+		{1e4, 5.6418958072680841152351573e-5},
+		{1e8, 5.6418958354775625873860027e-9},
+	}
+
+	for _, tc := range testCases {
+		if y := ErfcxReal(tc.x); close(y, tc.y) == false {
+			t.Fatalf("ErfcxReal(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+func TestErfiReal(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{1e-200, 1.1283791670955125536984679e-200},
+		{-0.1, -1.1321517416959979928940229e-1},
+		{0.5, 6.1495209469651098083968119e-1},
+		{1, 1.6504257587975428760253377e+0},
+		{-2, -1.8564802414575552598704292e+1},
+		{4, 1.2969597307176392315279410e+6},
+		{6.4, 5.4880872608904542949689255e+16},
+		{6.6, 7.1595491037404315298179172e+17},
+		{-10, -1.5243074227086696993605466e+42},
+		{20, 1.4747975396287862024477332e+172},
+		{26, 8.3146371647309876552995664e+291},
+		{26.65, 5.9121685816187087318688591e+306},
+		{-26.7, -8.4998672612689850585900294e+307},
+		{26.71, 1.4494591189327309559576928e+308},
+	}
+
+	for _, tc := range testCases {
+		if y := ErfiReal(tc.x); close(y, tc.y) == false {
+			t.Fatalf("ErfiReal(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+func TestDawsonReal(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{1e-200, 9.9999999999999998210026240e-201},
+		{-0.1, -9.9335992397852866590618712e-2},
+		{0.5, 4.2443638350202229593404235e-1},
+		{0.92413887300459176701, 5.4104422463518169847275933e-1},
+		{1, 5.3807950691276841913638742e-1},
+		{-2, -3.0134038892379196603466444e-1},
+		{4, 1.2934800123600511559147053e-1},
+		{6.4, 7.9115935911133727726960319e-2},
+		{6.6, 7.6658970228914293710448071e-2},
+		{-10, -5.0253847187598528032748420e-2},
+		{100, 5.0002500375093782827273751e-3},
+		{1e10, 5.0000000000000000000250000e-11},
+	}
+
+	for _, tc := range testCases {
+		if y := DawsonReal(tc.x); close(y, tc.y) == false {
+			t.Fatalf("DawsonReal(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+func TestRealAgreesWithMath(t *testing.T) {
+	for x := -6.0; x <= 27; x += 0.0625 {
+		if y, want := ErfReal(x), math.Erf(x); close(y, want) == false {
+			t.Fatalf("ErfReal(%v): expected %v, got %v", x, want, y)
+		}
+		if y, want := ErfcReal(x), math.Erfc(x); soclose(y, want, 1e-13) == false {
+			t.Fatalf("ErfcReal(%v): expected %v, got %v", x, want, y)
+		}
+		// the real functions agree with the complex functions on the real axis
+		if y, want := ErfcxReal(x), real(Erfcx(complex(x, 0))); soclose(y, want, 1e-13) == false {
+			t.Fatalf("ErfcxReal(%v): expected %v, got %v", x, want, y)
+		}
+		if x > -6 && x < 6 {
+			if y, want := DawsonReal(x), real(Dawson(complex(x, 0))); soclose(y, want, 1e-13) == false {
+				t.Fatalf("DawsonReal(%v): expected %v, got %v", x, want, y)
+			}
+		}
+	}
+}
+
+func TestRealSpecialCases(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		f    func(float64) float64
+		name string
+		x, y float64
+	}{
+		{ErfReal, "ErfReal", inf, 1},
+		{ErfReal, "ErfReal", -inf, -1},
+		{ErfReal, "ErfReal", 0, 0},
+		{ErfcReal, "ErfcReal", inf, 0},
+		{ErfcReal, "ErfcReal", -inf, 2},
+		{ErfcReal, "ErfcReal", 27.3, 0},
+		{ErfcxReal, "ErfcxReal", inf, 0},
+		{ErfcxReal, "ErfcxReal", -27, inf},
+		{ErfiReal, "ErfiReal", inf, inf},
+		{ErfiReal, "ErfiReal", -27, -inf},
+		{DawsonReal, "DawsonReal", inf, 0},
+		{DawsonReal, "DawsonReal", 0, 0},
+	}
+
+	for _, tc := range testCases {
+		if y := tc.f(tc.x); y != tc.y {
+			t.Fatalf("%v(%v): expected %v, got %v", tc.name, tc.x, tc.y, y)
+		}
+		if y := tc.f(math.NaN()); math.IsNaN(y) == false {
+			t.Fatalf("%v(NaN): expected NaN, got %v", tc.name, y)
+		}
+	}
+}
+
+func TestErfInv(t *testing.T) {
+	testCases := []struct {
+		y, x float64
+	}{
+		// extended precision values
+		{1e-300, 8.8622692545275803585712565e-301},
+		{-1e-10, -8.8622692545275804593859809e-11},
+		{0.01, 8.8625012809505980922989225e-3},
+		{0.3, 2.7246271472675434502465280e-1},
+		{-0.5, -4.7693627620446987338141835e-1},
+		{0.75, 8.1341984759761854169028936e-1},
+		{0.9, 1.1630871536766741628440954e+0},
+		{-0.999, -2.3267537655135244938664337e+0},
+		{0.999999, 3.4589107372754987775324488e+0},
+		{0.999999999999999, 5.6759157397447131788202050e+0},
+	}
+
+	for _, tc := range testCases {
+		if x := ErfInv(tc.y); close(x, tc.x) == false {
+			t.Fatalf("ErfInv(%v): expected %v, got %v", tc.y, tc.x, x)
+		}
+	}
+}
+
+func TestErfcInv(t *testing.T) {
+	testCases := []struct {
+		y, x float64
+	}{
+		// extended precision values
+		{5e-324, 2.7213293210812948815313824e+1},
+		{1e-310, 2.6644806559364764782010368e+1},
+		{1e-300, 2.6209469960516123885520732e+1},
+		{1e-100, 1.5065574702592645703742567e+1},
+		{1e-20, 6.6015806223551425656243459e+0},
+		{1e-05, 3.1234132743408750177399315e+0},
+		{0.01, 1.8213863677184496679503492e+0},
+		{0.3, 7.3286907795921686905383104e-1},
+		{0.6, 3.7080715859355795163699281e-1},
+		{1.2, -1.7914345462129163585284213e-1},
+		{1.99, -1.8213863677184494558728021e+0},
+		{1.999999999999, -5.0420210941134724806011188e+0},
+	}
+
+	for _, tc := range testCases {
+		if x := ErfcInv(tc.y); close(x, tc.x) == false {
+			t.Fatalf("ErfcInv(%v): expected %v, got %v", tc.y, tc.x, x)
+		}
+	}
+}
+
+func TestErfcxInv(t *testing.T) {
+	testCases := []struct {
+		y, x float64
+	}{
+		// extended precision values
+		{1e-300, 5.6418958354775627281000086e+299},
+		{1e-10, 5.6418958354775626638455095e+9},
+		{0.01, 5.6410097476729354298566241e+1},
+		{0.1, 5.5545858925411286273391810e+0},
+		{0.5, 7.6907977106131420523916215e-1},
+		{0.9, 9.6278647767749621332590217e-2},
+		{0.99999, 8.8623388592165247706768734e-6},
+		{0.9999999999, 8.8622699884910672647831733e-11},
+		{0.9999999999999998, 1.9678190753608286027747820e-16},
+		{1.0000000000000002, -1.9678190753608279164253658e-16},
+		{1.0000000001, -8.8622699870989850352124746e-11},
+		{1.000001, -8.8622622933948092530740387e-7},
+		{1.1, -8.2237098703542191659231444e-2},
+		{2, -5.1519807748248336686530895e-1},
+		{10, -1.2825423843925311539615535e+0},
+		{1e+10, -4.7257490146422502401953135e+0},
+		{1e+300, -2.6269419116487021398898446e+1},
+	}
+
+	for _, tc := range testCases {
+		if x := ErfcxInv(tc.y); close(x, tc.x) == false {
+			t.Fatalf("ErfcxInv(%v): expected %v, got %v", tc.y, tc.x, x)
+		}
+	}
+}
+
+func TestErfInvRoundTrip(t *testing.T) {
+	for y := -0.9990234375; y < 1; y += 0.0009765625 {
+		if x := ErfInv(y); close(ErfReal(x), y) == false {
+			t.Fatalf("ErfReal(ErfInv(%v)): expected %v, got %v", y, y, ErfReal(x))
+		}
+	}
+	// erfc(x) is resolved to full relative accuracy deep into the tail, where it is
+	// ill-conditioned by the factor 2x² relative to x
+	for y := 1e-300; y < 2; y *= 2.5 {
+		x := ErfcInv(y)
+		if soclose(ErfcReal(x), y, 1e-14*(1+2*x*x)) == false {
+			t.Fatalf("ErfcReal(ErfcInv(%v)): expected %v, got %v", y, y, ErfcReal(x))
+		}
+	}
+	for y := 1e-300; y < 1e300; y *= 7.5 {
+		x := ErfcxInv(y)
+		if soclose(ErfcxReal(x), y, 1e-14*(1+2*x*x)) == false {
+			t.Fatalf("ErfcxReal(ErfcxInv(%v)): expected %v, got %v", y, y, ErfcxReal(x))
+		}
+	}
+}
+
+func TestErfInvComplex(t *testing.T) {
+	testCases := []struct {
+		z, w complex128
+	}{
+		// extended precision values
+		{complex(0.5, 0.5), complex(0.37563349756229114480599845, 0.48219853952665113395107330)},
+		{complex(0.001, 0.002), complex(0.00088622437330765595659526248, 0.0017724533868733358842855916)},
+		{complex(2, 0), complex(0.86941756532618412132537603, 1.3405948185302771160936269)},
+		{complex(-3, 0), complex(-0.73040622613038534708154759, 1.5070792338186798632866664)},
+		{complex(1, 1), complex(0.45357488889165390832270671, 0.92149196736992922738895972)},
+		{complex(0.999, 0.001), complex(2.2617917967191723127719369, 0.16013405521441368827888037)},
+		{complex(0, 10), complex(0, 1.8003080434321563761887810)},
+		{complex(100, 100), complex(0.17287027691518935396129140, 2.5256516860291150184735601)},
+		{complex(-0.3, -2), complex(-0.078247966826116919989638009, -1.1091324715546872159669523)},
+		{complex(3, 4), complex(0.26686002596088931253402175, 1.5616082470696458861136364)},
+		{complex(1.5, -0.01), complex(1.0225427116776126318207395, -1.1899092387169413753535315)},
+		{complex(-1000, 10000), complex(-0.015892350287247914509299020, 3.3061784114401477283349313)},
+	}
+
+	for _, tc := range testCases {
+		if w := ErfInvComplex(tc.z); cmplx.Abs(w-tc.w) > 1e-14*cmplx.Abs(tc.w) {
+			t.Fatalf("ErfInvComplex(%v): expected %v, got %v", tc.z, tc.w, w)
+		}
+	}
+}
+
+func TestErfInvComplexRoundTrip(t *testing.T) {
+	for _, r := range []float64{1e-10, 0.1, 0.9, 1, 1.5, 2, 2.5, 10, 1e5, 1e100} {
+		for k := 0; k < 64; k++ {
+			z := cmplx.Rect(r, math.Pi*float64(k)/32)
+			w := ErfInvComplex(z)
+			// erf(w) is ill-conditioned by the factor 2|w|² relative to w
+			if cmplx.Abs(Erf(w)-z) > 1e-14*(1+2*cmplx.Abs(w*w))*r {
+				t.Fatalf("Erf(ErfInvComplex(%v)): expected %v, got %v", z, z, Erf(w))
+			}
+		}
+	}
+	// the inverse is continuous with the real inverse on (-1, 1) and takes the imaginary axis to itself
+	for _, y := range []float64{-0.9, 0.3, 0.99} {
+		if w := ErfInvComplex(complex(y, 1e-300)); veryclose(real(w), ErfInv(y)) == false {
+			t.Fatalf("ErfInvComplex(%v): expected %v, got %v", complex(y, 1e-300), ErfInv(y), w)
+		}
+	}
+	for _, y := range []float64{0.5, 3, 1e10} {
+		if w := ErfInvComplex(complex(0, y)); real(w) != 0 || close(ErfiReal(imag(w)), y) == false {
+			t.Fatalf("ErfInvComplex(%v): expected erfi(imag(w)) = %v, got %v", complex(0, y), y, w)
+		}
+	}
+}
+
+func TestErfInvSpecialCases(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		f    func(float64) float64
+		name string
+		y, x float64
+	}{
+		{ErfInv, "ErfInv", 1, inf},
+		{ErfInv, "ErfInv", -1, -inf},
+		{ErfInv, "ErfInv", 0, 0},
+		{ErfcInv, "ErfcInv", 0, inf},
+		{ErfcInv, "ErfcInv", 2, -inf},
+		{ErfcInv, "ErfcInv", 1, 0},
+		{ErfcxInv, "ErfcxInv", 0, inf},
+		{ErfcxInv, "ErfcxInv", inf, -inf},
+		{ErfcxInv, "ErfcxInv", 1, 0},
+	}
+
+	for _, tc := range testCases {
+		if x := tc.f(tc.y); x != tc.x {
+			t.Fatalf("%v(%v): expected %v, got %v", tc.name, tc.y, tc.x, x)
+		}
+		if x := tc.f(math.NaN()); math.IsNaN(x) == false {
+			t.Fatalf("%v(NaN): expected NaN, got %v", tc.name, x)
+		}
+		if x := tc.f(-1.5); math.IsNaN(x) == false {
+			t.Fatalf("%v(-1.5): expected NaN, got %v", tc.name, x)
+		}
+	}
+}
+
 // The floating point comparison tests are copied from from math/all_test.go.
 func tolerance(a, b, e float64) bool {
 	// Multiplying by e here can underflow denormal values to zero.
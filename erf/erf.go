@@ -12,12 +12,51 @@ import (
 
 // Erf computes approximate values for the error function
 func Erf(z complex128) complex128 {
-	return 1 - toms.Faddeyeva(1i*z)*cmplx.Exp(-z*z)
+	x, y := real(z), imag(z)
+	switch {
+	case y == 0:
+		return complex(ErfReal(x), y)
+	case x == 0:
+		return complex(x, ErfiReal(y))
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case cmplx.Abs(z) < 0.5:
+		return 2 / math.SqrtPi * erfTaylor(z)
+	case nearImaginaryAxis(x, y):
+		return erfImaginaryAxis(x, y)
+	case nearRealAxis(x, y):
+		return complex(ErfReal(x), 0) + erfRealAxis(x, y)
+	case (y-x)*(x+y) < -750:
+		return complex(math.Copysign(1, x), 0)
+	case x >= 0:
+		return 1 - erfcRight(x, y)
+	}
+	return erfcRight(-x, -y) - 1
 }
 
 // Erfc computes approximate values for the complementary error function erfc(z) = 1 - erf(z)
 func Erfc(z complex128) complex128 {
-	return toms.Faddeyeva(1i*z) * cmplx.Exp(-z*z)
+	x, y := real(z), imag(z)
+	switch {
+	case y == 0:
+		return complex(ErfcReal(x), -y)
+	case x == 0:
+		return complex(1, -ErfiReal(y))
+	case math.IsNaN(x) || math.IsNaN(y):
+		return cmplx.NaN()
+	case cmplx.Abs(z) < 0.5 || nearImaginaryAxis(x, y):
+		return 1 - Erf(z)
+	case nearRealAxis(x, y):
+		return complex(ErfcReal(x), 0) - erfRealAxis(x, y)
+	case (y-x)*(x+y) < -750:
+		if x >= 0 {
+			return 0
+		}
+		return 2
+	case x >= 0:
+		return erfcRight(x, y)
+	}
+	return 2 - erfcRight(-x, -y)
 }
 
 // Erfcx computes approximate values for the scaled complementary error function erfcx(z) = exp(z^2) * erfc(z)
@@ -27,7 +66,8 @@ func Erfcx(z complex128) complex128 {
 
 // Erfi computes approximate values for the imaginary error function erfi(z) = -i*erf(iz).
 func Erfi(z complex128) complex128 {
-	return -1i * Erf(1i*z)
+	e := Erf(complex(-imag(z), real(z)))
+	return complex(imag(e), -real(e))
 }
 
 // Dawson computes approximate values for the Dawson function (integral). Dawson function is the one-sided Fourier–Laplace sine transform of the Gaussian function.
@@ -0,0 +1,170 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package erf
+
+import (
+	"github.com/dreading/gospecfunc/erf/internal/toms"
+	"math"
+	"math/cmplx"
+)
+
+// Computing erf(z) = 1 - exp(-z²) w(iz) from the Faddeyeva function w cancels wherever erf(z)
+// is small compared with 1, which is near the origin and near the imaginary axis, where
+// erf(x+iy) is close to i erfi(y). Erf, Erfc and Erfi instead use the Taylor series
+//    erf(z) = 2/√π Σ (-1)^k z^(2k+1) / (k! (2k+1))
+// for |z| < 1/2, and where |x| exp(y²) < 1 the expansion about the imaginary axis
+//    erf(x+iy) = i erfi(y) + 2/√π exp(y²) Σ (-i)^n h(n) x^(n+1) / (n+1)!
+// with h(0) = 1, h(1) = 2y and h(n+1) = 2y h(n) + 2n h(n-1), in which (-i)^n h(n) = H(n)(-iy)
+// are the Hermite polynomials. Likewise near the real axis, where Im erf(x+iy) is small, they
+// use the expansion about x
+//    erf(x+iy) = erf(x) + 2/√π exp(-x²) Σ i (-i)^n H(n)(x) y^(n+1) / (n+1)!
+// for |y| < 1/2 and |xy| < 1, with erfc(x) in place of erf(x) for erfc. Elsewhere erfc(z) for Re z ≥ 0 is exp(-z²) w(iz), with exp(-z²)
+// computed from an exact split of z², and for large |z| the continued fraction
+//    erfc(z) = exp(-z²)/√π 1/(z + (1/2)/(z + 1/(z + (3/2)/(z + ...))))
+// The left half plane follows from erfc(-z) = 2 - erfc(z). See S. G. Johnson, Faddeeva
+// Package, http://ab-initio.mit.edu/Faddeeva, and DLMF 7.9.2 and 7.6.1.
+
+// erfTaylor computes √π/2 erf(z) from its Taylor series for |z| < 1/2
+func erfTaylor(z complex128) complex128 {
+	mz2 := -z * z
+	t, s := z, z
+	for k := 1; ; k++ {
+		t *= mz2 / complex(float64(k), 0)
+		u := t / complex(float64(2*k+1), 0)
+		s += u
+		if math.Abs(real(u)) <= machineEpsilon/4*math.Abs(real(s)) &&
+			math.Abs(imag(u)) <= machineEpsilon/4*math.Abs(imag(s)) {
+			return s
+		}
+	}
+}
+
+// nearImaginaryAxis reports whether erf(x+iy) is found from its expansion about iy, which
+// is where |Re erf(x+iy)| is of order |x| exp(y²) < 1
+func nearImaginaryAxis(x float64, y float64) bool {
+	return math.Abs(x) < 0.5 && math.Log(math.Abs(x))+y*y < 0
+}
+
+// erfImaginaryAxis computes erf(x+iy) from its expansion about iy for small |x| exp(y²)
+func erfImaginaryAxis(x float64, y float64) complex128 {
+	var re, im float64
+	hp, h := 0.0, 1.0
+	p := x // x^(n+1) / n!
+	for n := 0; n < 100; n++ {
+		u := h * p / float64(n+1)
+		switch n % 4 {
+		case 0:
+			re += u
+		case 1:
+			im -= u
+		case 2:
+			re -= u
+		case 3:
+			im += u
+		}
+		if n > 1 && math.Abs(u) <= machineEpsilon/4*math.Abs(re) {
+			break
+		}
+		hp, h = h, 2*y*h+2*float64(n)*hp
+		p *= x / float64(n+1)
+	}
+	e := 2 / math.SqrtPi * expx2(y, 1)
+	return complex(e*re, ErfiReal(y)+e*im)
+}
+
+// nearRealAxis reports whether erf(x+iy) is found from its expansion about x
+func nearRealAxis(x float64, y float64) bool {
+	return math.Abs(y) < 0.5 && math.Abs(x*y) < 1
+}
+
+// erfRealAxis computes erf(x+iy) - erf(x) from its expansion about x for |y| < 1/2 and |xy| < 1
+func erfRealAxis(x float64, y float64) complex128 {
+	var re, im float64
+	hp, h := 0.0, 1.0
+	p := y // y^(n+1) / (n+1)!
+	for n := 0; n < 100; n++ {
+		u := h * p
+		switch n % 4 {
+		case 0:
+			im += u
+		case 1:
+			re += u
+		case 2:
+			im -= u
+		case 3:
+			re -= u
+		}
+		if n > 1 && math.Abs(u) <= machineEpsilon/4*math.Abs(im) {
+			break
+		}
+		hp, h = h, 2*x*h-2*float64(n)*hp
+		p *= y / float64(n+2)
+	}
+	e := 2 / math.SqrtPi * expx2(x, -1)
+	return complex(e*re, e*im)
+}
+
+// erfcRight computes erfc(x+iy) for x ≥ 0
+func erfcRight(x float64, y float64) complex128 {
+	if x > 2 || (x > 0.5 && math.Abs(y) > 6) || math.Abs(y) > 8 {
+		return expmz2(x, y) * erfcxFraction(complex(x, y))
+	}
+	return expmz2(x, y) * toms.Faddeyeva(complex(-y, x))
+}
+
+// erfcxFraction computes exp(z²) erfc(z) from its continued fraction by the modified Lentz
+// method, which converges in fewer than about 60 terms for Re z > 2, for Re z > 1/2 and
+// |Im z| > 6, and for Re z ≥ 0 and |Im z| > 8
+func erfcxFraction(z complex128) complex128 {
+	const tiny = 1e-300
+	f := z
+	c, d := f, complex(0, 0)
+	for k := 1; k < 1000; k++ {
+		a := complex(float64(k)/2, 0)
+		d = z + a*d
+		if d == 0 {
+			d = tiny
+		}
+		c = z + a/c
+		if c == 0 {
+			c = tiny
+		}
+		d = 1 / d
+		δ := c * d
+		f *= δ
+		if cmplx.Abs(δ-1) < machineEpsilon/2 {
+			break
+		}
+	}
+	return 1 / (math.SqrtPi * f)
+}
+
+// expmz2 computes exp(-z²) for z = x+iy, with -z² = y² - x² - 2ixy formed exactly as
+// unevaluated sums so that its rounding is not magnified by the exponential for large |z|
+func expmz2(x float64, y float64) complex128 {
+	x2, x2l := twoprod(x, x)
+	y2, y2l := twoprod(y, y)
+	r, rl := twosum(y2, -x2)
+	rl += y2l - x2l
+	θ, θl := twoprod(-2*x, y)
+	m := math.Exp(r) * (1 + rl)
+	sin, cos := math.Sincos(θ)
+	return complex(m*(cos-θl*sin), m*(sin+θl*cos))
+}
+
+// twoprod computes a*b = p + e exactly by Dekker's algorithm
+func twoprod(a float64, b float64) (float64, float64) {
+	p := a * b
+	ah, al := veltkamp(a)
+	bh, bl := veltkamp(b)
+	return p, ((ah*bh - p) + ah*bl + al*bh) + al*bl
+}
+
+// twosum computes a+b = s + e exactly by Knuth's algorithm
+func twosum(a float64, b float64) (float64, float64) {
+	s := a + b
+	v := s - a
+	return s, (a - (s - v)) + (b - v)
+}
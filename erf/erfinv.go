@@ -0,0 +1,272 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package erf
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// The inverse error functions start from the single precision approximations of M. Giles,
+// Approximating the erfinv function, GPU Computing Gems Jade Edition (2011) 109-116, in the
+// variable w = -log((1-x)(1+x)), or deep in the tails, where w is beyond their range, from
+// the asymptotic solution x² = t - log(√(πt)) of erfc(x) = y with t = -log(y). The result is
+// polished by Halley's method against ErfReal for |x| ≤ 1/2, and otherwise against
+//    log erfc(x) = log erfcx(x) - x²
+// whose derivatives -2/(√π erfcx(x)) and 2x/(√π erfcx(x)) - 4/(π erfcx(x)²) need no
+// exponentials, so that erfc(x) = y is solved without underflow for all y > 0. The inverse of
+// erfcx is found by Newton's method on log erfcx(x) = log(y) safeguarded by the bounds
+//    2/(√π (x + √(x²+2))) < erfcx(x) ≤ 2/(√π (x + √(x²+4/π)))
+// for x ≥ 0, and 2 exp(x²) - 1 < erfcx(x) < 2 exp(x²) for x < 0. ErfInvComplex is the principal
+// branch, continuous with ErfInv on (-1, 1) and with branch cuts along the real axis for
+// |x| > 1, on which the sign of a zero imaginary part selects the side. It is reduced to the
+// first quadrant by symmetry and found by Newton's method, from Giles' approximation continued
+// analytically for |z| ≤ 2, and otherwise from the asymptotic form of erfi(v) = -iz with w = iv.
+
+// ErfInv computes the inverse error function x = erf⁻¹(y) for -1 ≤ y ≤ 1
+func ErfInv(y float64) float64 {
+	switch {
+	case math.IsNaN(y) || y < -1 || y > 1:
+		return math.NaN()
+	case y == 1:
+		return math.Inf(1)
+	case y == -1:
+		return math.Inf(-1)
+	case math.Abs(y) > 0.5:
+		// 1 - |y| is exact
+		return math.Copysign(erfcInvTail(1-math.Abs(y)), y)
+	case y == 0:
+		return y
+	}
+	x := giles(y)
+	for i := 0; i < 4; i++ {
+		// Halley's method with f = erf(x) - y, f' = 2/√π exp(-x²) and f'' = -2x f'
+		δ := (ErfReal(x) - y) / (2 / math.SqrtPi * math.Exp(-x*x))
+		δ /= 1 + x*δ
+		x -= δ
+		if math.Abs(δ) <= machineEpsilon*math.Abs(x) {
+			break
+		}
+	}
+	return x
+}
+
+// ErfcInv computes the inverse complementary error function x = erfc⁻¹(y) for 0 ≤ y ≤ 2
+func ErfcInv(y float64) float64 {
+	switch {
+	case math.IsNaN(y) || y < 0 || y > 2:
+		return math.NaN()
+	case y == 0:
+		return math.Inf(1)
+	case y == 2:
+		return math.Inf(-1)
+	case y > 1:
+		// 2 - y is exact
+		return -ErfcInv(2 - y)
+	case y >= 0.5:
+		// 1 - y is exact
+		return ErfInv(1 - y)
+	}
+	return erfcInvTail(y)
+}
+
+// erfcInvTail computes erfc⁻¹(y) for 0 < y < 1/2 by Halley's method on log erfc(x) = log(y)
+func erfcInvTail(y float64) float64 {
+	logy := math.Log(y)
+	if y < minNormal {
+		// math.Log is inaccurate for subnormal arguments on some architectures
+		logy = math.Log(y*(1<<54)) - 54*math.Ln2
+	}
+	var x float64
+	if w := -logy - math.Log(2-y); w < 16 {
+		x = gilesPoly(w) * (1 - y)
+	} else {
+		x = math.Sqrt(-logy - math.Log(math.Sqrt(-math.Pi*logy)))
+	}
+	for i := 0; i < 6; i++ {
+		e := ErfcxReal(x)
+		g := math.Log(e) - x*x - logy
+		a := 2 / (math.SqrtPi * e) // -g'
+		// g'' = a(2x - a)
+		δ := -g / a
+		δ /= 1 + δ*(2*x-a)/2
+		x -= δ
+		if math.Abs(δ) <= machineEpsilon*math.Abs(x) {
+			break
+		}
+	}
+	return x
+}
+
+// ErfcxInv computes the inverse scaled complementary error function x = erfcx⁻¹(y) for y > 0
+func ErfcxInv(y float64) float64 {
+	switch {
+	case math.IsNaN(y) || y < 0:
+		return math.NaN()
+	case y == 0:
+		return math.Inf(1)
+	case math.IsInf(y, 1):
+		return math.Inf(-1)
+	case y == 1:
+		return 0
+	}
+	var lo, hi float64
+	if y < 1 {
+		// invert the bounds 2/(√π (x + √(x²+c))) with c = 2 and c = 4/π
+		s := 2 / (math.SqrtPi * y)
+		if s > 1e150 {
+			// erfcx(x) = 1/(√π x) to within rounding
+			return 1 / (math.SqrtPi * y)
+		}
+		// s² - 4/π = 4(1-y)(1+y)/(π y²) keeps the upper bound above the root as y → 1
+		lo, hi = math.Max((s*s-2)/(2*s), 0), 2*(1-y)*(1+y)/(math.Pi*y*y*s)
+	} else {
+		lo, hi = -math.Sqrt(math.Log1p((y-1)/2)), -math.Sqrt(math.Max(math.Log(y/2), 0))
+	}
+	x := (lo + hi) / 2
+	for i := 0; i < 100; i++ {
+		// Newton's method on h = log erfcx(x) - log(y) with h' = 2x - 2/(√π erfcx(x)),
+		// bisecting whenever the step leaves the bracket. h is formed as log1p((e-y)/y), and
+		// for y near 1, where the root is small, e - y = (erfcx(x) - 1) + (1 - y) with 1 - y
+		// exact and erfcx(x) - 1 = expm1(x²) - exp(x²) erf(x), which do not cancel.
+		e := ErfcxReal(x)
+		d := e - y
+		if math.Abs(x) < 0.5 && y > 0.5 && y < 2 {
+			d = (math.Expm1(x*x) - expx2(x, 1)*ErfReal(x)) + (1 - y)
+		}
+		h := math.Log1p(d / y)
+		if h > 0 {
+			lo = x
+		} else {
+			hi = x
+		}
+		next := x - h/(2*x-2/(math.SqrtPi*e))
+		if !(next > lo && next < hi) {
+			next = (lo + hi) / 2
+		}
+		δ := next - x
+		x = next
+		if math.Abs(δ) <= machineEpsilon*math.Abs(x) || lo == hi {
+			break
+		}
+	}
+	return x
+}
+
+// ErfInvComplex computes the principal branch of the inverse error function w = erf⁻¹(z),
+// for which erf(w) = z
+func ErfInvComplex(z complex128) complex128 {
+	switch {
+	case imag(z) == 0 && math.Abs(real(z)) <= 1:
+		return complex(ErfInv(real(z)), imag(z))
+	case cmplx.IsNaN(z):
+		return cmplx.NaN()
+	}
+	switch {
+	case real(z) < 0:
+		return -ErfInvComplex(-z)
+	case math.Signbit(imag(z)):
+		return cmplx.Conj(ErfInvComplex(cmplx.Conj(z)))
+	}
+	var w complex128
+	if cmplx.Abs(z) <= 2 {
+		w = gilesComplex(z)
+	} else {
+		// iterate erfi(v) = -iz ~ exp(v²)/(√π v) with w = iv
+		v := cmplx.Sqrt(cmplx.Log(-1i * z))
+		for i := 0; i < 4; i++ {
+			v = cmplx.Sqrt(cmplx.Log(-1i * z * math.SqrtPi * v))
+		}
+		w = 1i * v
+	}
+	for i := 0; i < 50; i++ {
+		var δ complex128
+		if cmplx.Abs(z) < 0.5 {
+			// Newton's method with erf'(w) = 2/√π exp(-w²)
+			δ = (Erf(w) - z) / (2 / math.SqrtPi * cmplx.Exp(-w*w))
+		} else {
+			// Newton's method on log(erfc(w) / (1-z)), which grows like -w² rather than exp(-w²)
+			e := Erfc(w)
+			δ = -cmplx.Log(e/(1-z)) * e / (2 / math.SqrtPi * expmz2(real(w), imag(w)))
+		}
+		w -= δ
+		if cmplx.Abs(δ) <= machineEpsilon*cmplx.Abs(w) {
+			break
+		}
+	}
+	if real(z) == 0 {
+		// erf maps the imaginary axis to itself
+		return complex(0, imag(w))
+	}
+	return w
+}
+
+// minNormal is the smallest positive normal floating point number
+const minNormal = 2.2250738585072014e-308
+
+// giles computes erf⁻¹(y) to single precision for |y| < 1
+func giles(y float64) float64 {
+	return gilesPoly(-math.Log((1-y)*(1+y))) * y
+}
+
+// Giles' coefficients of erf⁻¹(y) / y in ascending powers of w - 2.5 for w < 5 and of √w - 3 otherwise
+var (
+	gilesCentral = []float64{
+		1.50140941,
+		0.246640727,
+		-0.00417768164,
+		-0.00125372503,
+		0.00021858087,
+		-4.39150654e-06,
+		-3.5233877e-06,
+		3.43273939e-07,
+		2.81022636e-08,
+	}
+	gilesTail = []float64{
+		2.83297682,
+		1.00167406,
+		0.00943887047,
+		-0.0076224613,
+		0.00573950773,
+		-0.00367342844,
+		0.00134934322,
+		0.000100950558,
+		-0.000200214257,
+	}
+)
+
+// gilesPoly computes erf⁻¹(y) / y in Giles' variable w = -log((1-y)(1+y))
+func gilesPoly(w float64) float64 {
+	c := gilesCentral
+	if w < 5 {
+		w -= 2.5
+	} else {
+		c = gilesTail
+		w = math.Sqrt(w) - 3
+	}
+	p := 0.0
+	for k := len(c) - 1; k >= 0; k-- {
+		p = p*w + c[k]
+	}
+	return p
+}
+
+// gilesComplex continues Giles' approximation of erf⁻¹(z) analytically to complex z
+func gilesComplex(z complex128) complex128 {
+	// 1 - z is formed explicitly to keep the sign of a zero imaginary part
+	w := -cmplx.Log(complex(1-real(z), -imag(z)) * (1 + z))
+	c := gilesCentral
+	if real(w) < 5 {
+		w -= 2.5
+	} else {
+		c = gilesTail
+		w = cmplx.Sqrt(w) - 3
+	}
+	p := complex(0, 0)
+	for k := len(c) - 1; k >= 0; k-- {
+		p = p*w + complex(c[k], 0)
+	}
+	return p * z
+}
@@ -0,0 +1,154 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package erf
+
+import (
+	"github.com/dreading/gospecfunc/erf/internal/libcerf"
+	"math"
+)
+
+// The real error functions follow S. G. Johnson's Faddeeva package. Erfc and Erf are found
+// from erfcx(x) = exp(x²) erfc(x), with the Taylor series of erf for |x| < 1/2 where
+// 1 - exp(-x²) erfcx(x) cancels. The Dawson function D(x) = exp(-x²) ∫ 0 to x exp(t²) dt
+// and erfi(x) = 2/√π exp(x²) D(x) use the series
+//    exp(x²) D(x) = Σ x^(2k+1) / (k! (2k+1))
+// of positive terms, and for large |x| the asymptotic expansion
+//    D(x) ~ 1/(2x) Σ (2k-1)!! / (2x²)^k
+// In all cases exp(±x²) is computed with x² split exactly into two parts, so that the
+// rounding error of x² is not magnified by the exponential for large |x|.
+
+// ErfReal computes the error function erf(x) for real x
+func ErfReal(x float64) float64 {
+	if x*x > 750 {
+		return math.Copysign(1, x)
+	}
+	if math.Abs(x) < 0.5 {
+		return 2 / math.SqrtPi * erfSeries(x)
+	}
+	if x >= 0 {
+		return 1 - expx2(x, -1)*libcerf.Erfcx(x)
+	}
+	return expx2(x, -1)*libcerf.Erfcx(-x) - 1
+}
+
+// ErfcReal computes the complementary error function erfc(x) = 1 - erf(x) for real x
+func ErfcReal(x float64) float64 {
+	if x*x > 750 {
+		if x >= 0 {
+			return 0
+		}
+		return 2
+	}
+	if x >= 0 {
+		return expx2(x, -1) * libcerf.Erfcx(x)
+	}
+	return 2 - expx2(x, -1)*libcerf.Erfcx(-x)
+}
+
+// ErfcxReal computes the scaled complementary error function erfcx(x) = exp(x²) erfc(x) for real x
+func ErfcxReal(x float64) float64 {
+	if x < 0 && x >= -26.7 {
+		// erfcx(x) = 2 exp(x²) - erfcx(-x)
+		return 2*expx2(x, 1) - libcerf.Erfcx(-x)
+	}
+	return libcerf.Erfcx(x)
+}
+
+// ErfiReal computes the imaginary error function erfi(x) = -i erf(ix) for real x
+func ErfiReal(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return x
+	case x*x > 730:
+		return math.Copysign(math.Inf(1), x)
+	case math.Abs(x) <= dawsonAsymptotic:
+		return 2 / math.SqrtPi * dawsonSeries(x)
+	}
+	// exp(x²) overflows for x² > 709.78 before erfi(x) ≈ exp(x²)/(√π x) does, so its
+	// halves are applied on either side of D(x)
+	h, l := splitx2(x)
+	e := math.Exp(h / 2)
+	return 2 / math.SqrtPi * e * (1 + l) * dawsonExpansion(x) * e
+}
+
+// DawsonReal computes the Dawson function D(x) = √π/2 exp(-x²) erfi(x) for real x
+func DawsonReal(x float64) float64 {
+	switch {
+	case math.IsNaN(x):
+		return x
+	case math.IsInf(x, 0):
+		return math.Copysign(0, x)
+	case math.Abs(x) <= dawsonAsymptotic:
+		return expx2(x, -1) * dawsonSeries(x)
+	}
+	return dawsonExpansion(x)
+}
+
+// erfSeries computes √π/2 erf(x) = Σ (-1)^k x^(2k+1) / (k! (2k+1)) for |x| < 0.5
+func erfSeries(x float64) float64 {
+	mx2 := -x * x
+	t, s := x, x
+	for k := 1; ; k++ {
+		t *= mx2 / float64(k)
+		u := t / float64(2*k+1)
+		s += u
+		if math.Abs(u) <= machineEpsilon/4*math.Abs(s) {
+			return s
+		}
+	}
+}
+
+// dawsonAsymptotic is the least |x| at which the asymptotic expansion of D(x), whose smallest
+// term is of order exp(-x²), is used
+const dawsonAsymptotic = 6.5
+
+// dawsonSeries computes exp(x²) D(x) from its series of positive terms
+func dawsonSeries(x float64) float64 {
+	x2 := x * x
+	t, s := x, x
+	for k := 1; ; k++ {
+		t *= x2 / float64(k)
+		u := t / float64(2*k+1)
+		s += u
+		if float64(k) > x2 && math.Abs(u) <= machineEpsilon/4*math.Abs(s) {
+			return s
+		}
+	}
+}
+
+// dawsonExpansion computes D(x) from its asymptotic expansion for |x| > dawsonAsymptotic
+func dawsonExpansion(x float64) float64 {
+	y := 1 / (2 * x * x)
+	t, s := 1.0, 1.0
+	for k := 1; t > machineEpsilon/4*s; k++ {
+		t *= float64(2*k-1) * y
+		s += t
+	}
+	return s / (2 * x)
+}
+
+// expx2 computes exp(sign x²) for sign = ±1, with x² = h + l split exactly by Dekker's
+// algorithm so that exp(sign x²) = exp(sign h) (1 + sign l) to within rounding
+func expx2(x float64, sign float64) float64 {
+	h, l := splitx2(x)
+	return math.Exp(sign*h) * (1 + sign*l)
+}
+
+// splitx2 computes x² = h + l exactly by Dekker's algorithm
+func splitx2(x float64) (float64, float64) {
+	h := x * x
+	xh, xl := veltkamp(x)
+	return h, ((xh*xh - h) + 2*xh*xl) + xl*xl
+}
+
+// veltkamp splits x = h + l with h and l of at most 26 significant bits
+func veltkamp(x float64) (float64, float64) {
+	c := 134217729 * x
+	h := c - (c - x)
+	return h, x - h
+}
+
+// machineEpsilon is the relative spacing of the floating point numbers near 1
+const machineEpsilon = 2.220446049250313e-16
@@ -0,0 +1,40 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hankel_test
+
+import (
+	. "github.com/dreading/gospecfunc/hankel"
+	"testing"
+)
+
+// Global exported variables are used to store the
+// return values of functions measured in the benchmarks.
+// Storing the results in these variables prevents the compiler
+// from completely optimizing the benchmarked functions away.
+var (
+	GlobalP *Plan
+	GlobalF []float64
+)
+
+func BenchmarkNewPlan(b *testing.B) {
+	var p *Plan
+	for n := 0; n < b.N; n++ {
+		p = NewPlan(0, 256, 1)
+	}
+	GlobalP = p
+}
+
+func BenchmarkForward(b *testing.B) {
+	p := NewPlan(0, 256, 1)
+	f, g := make([]float64, 256), make([]float64, 256)
+	for i := range f {
+		f[i] = 1
+	}
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		p.Forward(f, g)
+	}
+	GlobalF = g
+}
@@ -0,0 +1,159 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hankel_test
+
+import (
+	"github.com/dreading/gospecfunc/bessel"
+	. "github.com/dreading/gospecfunc/hankel"
+	"math"
+	"math/cmplx"
+	"testing"
+)
+
+func TestForward(t *testing.T) {
+	// the hankel transform of r^ν exp(-a² r²) is 2π k^ν / (2a²)^(ν+1) exp(-k²/4a²) with k = 2πρ
+	testCases := []struct {
+		ν, a, R float64
+		n       int
+	}{
+		{0, 2, 5, 256},
+		{1, 2, 5, 256},
+		{2.5, 1, 8, 200},
+		{4, 3, 4, 300},
+	}
+
+	for _, tc := range testCases {
+		p := NewPlan(tc.ν, tc.n, tc.R)
+		r, ρ := p.Radii(), p.Frequencies()
+		f := make([]float64, tc.n)
+		for i := range f {
+			f[i] = math.Pow(r[i], tc.ν) * math.Exp(-tc.a*tc.a*r[i]*r[i])
+		}
+		g := make([]float64, tc.n)
+		p.Forward(f, g)
+		scale := 2 * math.Pi / math.Pow(2*tc.a*tc.a, tc.ν+1)
+		for i := range g {
+			k := 2 * math.Pi * ρ[i]
+			want := scale * math.Pow(k, tc.ν) * math.Exp(-k*k/(4*tc.a*tc.a))
+			if math.Abs(g[i]-want) > 1e-13*scale*math.Pow(2*tc.a*tc.a*tc.ν/math.E, tc.ν/2) {
+				t.Errorf("Forward(%v, %v): expected %v at %v, got %v", tc.ν, tc.n, want, ρ[i], g[i])
+			}
+		}
+
+		// the transform is its own inverse
+		h := make([]float64, tc.n)
+		p.Inverse(g, h)
+		for i := range h {
+			if math.Abs(h[i]-f[i]) > 1e-13 {
+				t.Errorf("Inverse(%v, %v): expected %v at %v, got %v", tc.ν, tc.n, f[i], r[i], h[i])
+			}
+		}
+	}
+}
+
+func TestComplex(t *testing.T) {
+	// the transform is linear with a real kernel
+	p := NewPlan(1, 64, 2)
+	r := p.Radii()
+	re, im := make([]float64, 64), make([]float64, 64)
+	f := make([]complex128, 64)
+	for i, x := range r {
+		re[i], im[i] = x*math.Exp(-x*x), math.Sin(3*x)*(2-x)
+		f[i] = complex(re[i], im[i])
+	}
+	g, gre, gim := make([]complex128, 64), make([]float64, 64), make([]float64, 64)
+	p.ForwardComplex(f, g)
+	p.Forward(re, gre)
+	p.Forward(im, gim)
+	for i := range g {
+		if g[i] != complex(gre[i], gim[i]) {
+			t.Errorf("ForwardComplex(%v): expected %v, got %v", i, complex(gre[i], gim[i]), g[i])
+		}
+	}
+	// f does not vanish at R, so the round trip is limited by the orthogonality of the kernel
+	h := make([]complex128, 64)
+	p.InverseComplex(g, h)
+	ε := p.Orthogonality()
+	for i := range h {
+		if cmplx.Abs(h[i]-f[i]) > 64*ε {
+			t.Errorf("InverseComplex(%v): expected %v, got %v", i, f[i], h[i])
+		}
+	}
+}
+
+func TestEnergy(t *testing.T) {
+	// the discrete energies Σ |F(n)|² and Σ |G(m)|² of the scaled samples agree to within the
+	// orthogonality of the kernel
+	for _, ν := range []float64{0, 1, 4} {
+		for _, n := range []int{16, 128} {
+			p := NewPlan(ν, n, 3)
+			R, V := p.Radius(), p.Bandwidth()
+			j := bessel.JZeros(ν, n)
+			r := p.Radii()
+			f, g := make([]float64, n), make([]float64, n)
+			for i, x := range r {
+				f[i] = math.Cos(2*x) * (3 - x)
+			}
+			p.Forward(f, g)
+			var ef, eg float64
+			for i := range j {
+				a := math.Abs(real(bessel.J(ν+1, complex(j[i], 0))))
+				ef += f[i] * f[i] * R * R / (a * a)
+				eg += g[i] * g[i] * V * V / (a * a)
+			}
+			ε := p.Orthogonality()
+			if ε > 1e-5 || math.Abs(eg-ef) > 2*float64(n)*ε*ef {
+				t.Errorf("Forward(%v, %v): energy %v is not conserved, got %v with orthogonality %v", ν, n, ef, eg, ε)
+			}
+		}
+	}
+}
+
+func TestPlan(t *testing.T) {
+	const R = 2.5
+	p := NewPlan(3, 10, R)
+	j := bessel.JZeros(3, 11)
+	if p.Order() != 3 || p.Len() != 10 || p.Radius() != R {
+		t.Errorf("NewPlan(3, 10, %v): got order %v, length %v and radius %v", R, p.Order(), p.Len(), p.Radius())
+	}
+	if V := j[10] / (2 * math.Pi * R); math.Abs(p.Bandwidth()-V) > 1e-15*V {
+		t.Errorf("Bandwidth: expected %v, got %v", V, p.Bandwidth())
+	}
+	r, ρ := p.Radii(), p.Frequencies()
+	for i := range r {
+		if want := j[i] * R / j[10]; math.Abs(r[i]-want) > 1e-15*want {
+			t.Errorf("Radii(%v): expected %v, got %v", i, want, r[i])
+		}
+		if want := j[i] / (2 * math.Pi * R); math.Abs(ρ[i]-want) > 1e-15*want {
+			t.Errorf("Frequencies(%v): expected %v, got %v", i, want, ρ[i])
+		}
+	}
+	// the plan returns copies of its sample points
+	r[0] = -1
+	if p.Radii()[0] == -1 {
+		t.Errorf("Radii: the sample points of the plan were modified")
+	}
+}
+
+func TestPanic(t *testing.T) {
+	p := NewPlan(0, 8, 1)
+	for _, f := range []func(){
+		func() { NewPlan(-1, 8, 1) },
+		func() { NewPlan(0, 0, 1) },
+		func() { NewPlan(0, 8, 0) },
+		func() { p.Forward(make([]float64, 7), make([]float64, 8)) },
+		func() { p.Inverse(make([]float64, 8), make([]float64, 7)) },
+		func() { p.ForwardComplex(make([]complex128, 9), make([]complex128, 9)) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("the plan did not panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
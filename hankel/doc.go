@@ -0,0 +1,6 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package hankel provides quasi-discrete hankel transforms of radially symmetric functions
+package hankel
@@ -0,0 +1,98 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package normal_test
+
+import (
+	. "github.com/dreading/gospecfunc/normal"
+	"testing"
+)
+
+// Global exported variables are used to store the
+// return values of functions measured in the benchmarks.
+// Storing the results in these variables prevents the compiler
+// from completely optimizing the benchmarked functions away.
+var (
+	GlobalF float64
+)
+
+func BenchmarkPDF(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = PDF(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkCDF(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = CDF(0.9)
+	}
+	GlobalF = r
+}
+
+func BenchmarkCDFLowerTail(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = CDF(-12)
+	}
+	GlobalF = r
+}
+
+func BenchmarkLogCDF(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = LogCDF(-40)
+	}
+	GlobalF = r
+}
+
+func BenchmarkMillsRatio(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = MillsRatio(2.5)
+	}
+	GlobalF = r
+}
+
+func BenchmarkHazard(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = Hazard(2.5)
+	}
+	GlobalF = r
+}
+
+func BenchmarkQuantile(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = Quantile(0.975)
+	}
+	GlobalF = r
+}
+
+func BenchmarkOwensT(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = OwensT(1.2, 0.7)
+	}
+	GlobalF = r
+}
+
+func BenchmarkBivariateCDF(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = BivariateCDF(0.5, -1.3, 0.6)
+	}
+	GlobalF = r
+}
+
+func BenchmarkBivariateCDFLowerTail(b *testing.B) {
+	var r float64
+	for n := 0; n < b.N; n++ {
+		r = BivariateCDF(-6, -5, 0.3)
+	}
+	GlobalF = r
+}
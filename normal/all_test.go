@@ -0,0 +1,417 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package normal_test
+
+import (
+	. "github.com/dreading/gospecfunc/normal"
+	"math"
+	"testing"
+)
+
+func TestPDF(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-10, 7.6945986267064193463390336e-23},
+		{-1, 2.4197072451914334979783019e-1},
+		{0, 3.9894228040143267793994606e-1},
+		{0.5, 3.5206532676429947777468044e-1},
+		{3, 4.4318484119380071756023527e-3},
+		{20, 5.5209483621597631895827357e-88},
+		{37, 2.1200065515246056268520455e-298},
+	}
+
+	for _, tc := range testCases {
+		if y := PDF(tc.x); close(y, tc.y) == false {
+			t.Fatalf("PDF(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestCDF(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-37, 5.7255712225245768226831925e-300},
+		{-30, 4.9067139271481870595338093e-198},
+		{-20, 2.7536241186062336950756228e-89},
+		{-5, 2.8665157187919391167375233e-7},
+		{-1.5, 6.6807201268858066004494041e-2},
+		{-1, 1.5865525393145705141476745e-1},
+		{-0.3, 3.8208857781104736692772638e-1},
+		{0, 5.0000000000000000000000000e-1},
+		{0.5, 6.9146246127401310363770461e-1},
+		{2, 9.7724986805182079279971736e-1},
+		{8, 9.9999999999999937790394257e-1},
+	}
+
+	for _, tc := range testCases {
+		if y := CDF(tc.x); close(y, tc.y) == false {
+			t.Fatalf("CDF(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestSurvival(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-8, 9.9999999999999937790394257e-1},
+		{-1, 8.4134474606854294858523255e-1},
+		{0, 5.0000000000000000000000000e-1},
+		{0.7, 2.4196365222307302861621068e-1},
+		{1, 1.5865525393145705141476745e-1},
+		{3, 1.3498980316300945266518148e-3},
+		{10, 7.6198530241605260659733433e-24},
+		{37, 5.7255712225245768226831925e-300},
+	}
+
+	for _, tc := range testCases {
+		if y := Survival(tc.x); close(y, tc.y) == false {
+			t.Fatalf("Survival(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestLogCDF(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-1e5, -5.0000000124318639982749012e+9},
+		{-1000, -5.0000782669481218430980617e+5},
+		{-40, -8.0460844201375378816660683e+2},
+		{-10, -5.3231285150512470578347027e+1},
+		{-1.5, -2.7059444008238898069570566e+0},
+		{-1, -1.8410216450092635057707831e+0},
+		{-0.5, -1.1759117615936186088797291e+0},
+		{0, -6.9314718055994530941723212e-1},
+		{1, -1.7275377902344988952648317e-1},
+		{5, -2.8665161296376359338459626e-7},
+		{10, -7.6198530241605260659733723e-24},
+		{30, -4.9067139271481870595338093e-198},
+	}
+
+	for _, tc := range testCases {
+		if y := LogCDF(tc.x); close(y, tc.y) == false {
+			t.Fatalf("LogCDF(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestMillsRatio(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-30, 6.7858896130611187257397768e+195},
+		{-5, 6.7262163672287925230729800e+5},
+		{-1, 3.4770518117036944669255207e+0},
+		{0, 1.2533141373155002512078826e+0},
+		{1, 6.5567954241879847154387123e-1},
+		{10, 9.9028596471731921395337189e-2},
+		{1000, 9.9999900000299998500010500e-4},
+		{1e8, 9.9999999999999990000000000e-9},
+	}
+
+	for _, tc := range testCases {
+		if y := MillsRatio(tc.x); close(y, tc.y) == false {
+			t.Fatalf("MillsRatio(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestHazard(t *testing.T) {
+	testCases := []struct {
+		x, y float64
+	}{
+		// extended precision values
+		{-30, 1.4736461348785475190494933e-196},
+		{-5, 1.4867199409049057124417441e-6},
+		{-1, 2.8759997093917836122867013e-1},
+		{0, 7.9788456080286535587989212e-1},
+		{1, 1.5251352761609812090890905e+0},
+		{10, 1.0098093233962511962843642e+1},
+		{1000, 1.0000009999980000099999260e+3},
+		{1e8, 1.0000000000000001000000000e+8},
+	}
+
+	for _, tc := range testCases {
+		if y := Hazard(tc.x); close(y, tc.y) == false {
+			t.Fatalf("Hazard(%v): expected %v, got %v", tc.x, tc.y, y)
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	testCases := []struct {
+		p, x float64
+	}{
+		// extended precision values
+		{5e-324, -3.8467405617144346250784362e+1},
+		{1e-300, -3.7047096299361199236547043e+1},
+		{1e-10, -6.3613409024040561991003969e+0},
+		{0.025, -1.9599639845400542117795842e+0},
+		{0.3, -5.2440051270804081596945436e-1},
+		{0.5, 0},
+		{0.9, 1.2815515655446005934874483e+0},
+		{0.975, 1.9599639845400538556044306e+0},
+		{0.9999999999, 6.3613408896974218641554418e+0},
+	}
+
+	for _, tc := range testCases {
+		if x := Quantile(tc.p); close(x, tc.x) == false {
+			t.Fatalf("Quantile(%v): expected %v, got %v", tc.p, tc.x, x)
+		}
+	}
+}
+
+func TestConsistency(t *testing.T) {
+	for x := -37.0; x <= 37; x += 0.125 {
+		// the lower tail is resolved to full relative accuracy
+		if x < 0 {
+			if p := CDF(x); close(Quantile(p), x) == false {
+				t.Fatalf("Quantile(CDF(%v)): expected %v, got %v", x, x, Quantile(p))
+			}
+			if y, want := LogCDF(x), math.Log(CDF(x)); close(y, want) == false {
+				t.Fatalf("LogCDF(%v): expected %v, got %v", x, want, y)
+			}
+		}
+		if y, want := Hazard(x), PDF(x)/Survival(x); close(y, want) == false {
+			t.Fatalf("Hazard(%v): expected %v, got %v", x, want, y)
+		}
+		if y := CDF(x) + Survival(x); close(y, 1) == false {
+			t.Fatalf("CDF(%v) + Survival(%v): expected 1, got %v", x, x, y)
+		}
+	}
+}
+
+func TestSpecialCases(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		f    func(float64) float64
+		name string
+		x, y float64
+	}{
+		{PDF, "PDF", inf, 0},
+		{PDF, "PDF", -inf, 0},
+		{CDF, "CDF", inf, 1},
+		{CDF, "CDF", -inf, 0},
+		{CDF, "CDF", -40, 0},
+		{Survival, "Survival", inf, 0},
+		{Survival, "Survival", -inf, 1},
+		{LogCDF, "LogCDF", -inf, -inf},
+		{LogCDF, "LogCDF", inf, 0},
+		{MillsRatio, "MillsRatio", inf, 0},
+		{MillsRatio, "MillsRatio", -inf, inf},
+		{Hazard, "Hazard", inf, inf},
+		{Quantile, "Quantile", 0, -inf},
+		{Quantile, "Quantile", 1, inf},
+	}
+
+	for _, tc := range testCases {
+		if y := tc.f(tc.x); y != tc.y {
+			t.Fatalf("%v(%v): expected %v, got %v", tc.name, tc.x, tc.y, y)
+		}
+		if y := tc.f(math.NaN()); math.IsNaN(y) == false {
+			t.Fatalf("%v(NaN): expected NaN, got %v", tc.name, y)
+		}
+	}
+	for _, p := range []float64{-0.5, 1.5} {
+		if x := Quantile(p); math.IsNaN(x) == false {
+			t.Fatalf("Quantile(%v): expected NaN, got %v", p, x)
+		}
+	}
+}
+
+func TestOwensT(t *testing.T) {
+	testCases := []struct {
+		h, a, y float64
+	}{
+		// extended precision values
+		{0, 3, 1.9879180882521663708770054e-1},
+		{0.01, 0.99999, 1.2499124681897660460342151e-1},
+		{0.1, 0.2, 3.1257726663751530942493954e-2},
+		{0.2, 5, 2.0401501587832290678340396e-1},
+		{0.3, 0.05, 7.6009712304818883319223516e-3},
+		{0.5, 0.5, 6.4488602847503757028281014e-2},
+		{1, 1, 6.6741882165700966622733669e-2},
+		{1.2, 0.7, 4.2797442603088491702128694e-2},
+		{1.5, 30, 3.3403600634429033002247020e-2},
+		{2, 0.3, 5.9286080308985148639939955e-3},
+		{3, 2, 6.7494901553521614893408906e-4},
+		{4, 0.6, 1.5648345594205674423928726e-5},
+		{5, 0.95, 1.4332563294912187781252265e-7},
+		{6, 2, 4.9329382251884907035043207e-10},
+		{7, 0.1, 3.3568235659831495405837578e-13},
+		{10, 0.3, 3.8005536292156161425869851e-24},
+		{20, 0.5, 1.3768120593031168475377946e-89},
+		{30, 0.01, 5.7917996374072844079236781e-199},
+	}
+
+	for _, tc := range testCases {
+		if y := OwensT(tc.h, tc.a); close(y, tc.y) == false {
+			t.Fatalf("OwensT(%v, %v): expected %v, got %v", tc.h, tc.a, tc.y, y)
+		}
+		// T(h,a) = T(-h,a) = -T(h,-a)
+		if y := OwensT(-tc.h, tc.a); y != OwensT(tc.h, tc.a) {
+			t.Fatalf("OwensT(%v, %v): expected %v, got %v", -tc.h, tc.a, tc.y, y)
+		}
+		if y := OwensT(tc.h, -tc.a); y != -OwensT(tc.h, tc.a) {
+			t.Fatalf("OwensT(%v, %v): expected %v, got %v", tc.h, -tc.a, -tc.y, y)
+		}
+	}
+}
+
+func TestOwensTIdentities(t *testing.T) {
+	for _, h := range []float64{0.001, 0.1, 0.7, 1.6, 2.5, 4, 6, 9} {
+		// T(h,1) = Φ(h)Φ(-h)/2
+		if y, w := OwensT(h, 1), CDF(h)*Survival(h)/2; close(y, w) == false {
+			t.Fatalf("OwensT(%v, 1): expected %v, got %v", h, w, y)
+		}
+		// T(h,∞) = Φ(-h)/2
+		if y, w := OwensT(h, math.Inf(1)), Survival(h)/2; close(y, w) == false {
+			t.Fatalf("OwensT(%v, +Inf): expected %v, got %v", h, w, y)
+		}
+		// T(h,a) + T(ah,1/a) = (Φ(-h) + Φ(-ah))/2 - Φ(-h)Φ(-ah) for h ≥ 0
+		for _, a := range []float64{0.05, 0.3, 0.8, 0.99} {
+			w := (Survival(h)+Survival(a*h))/2 - Survival(h)*Survival(a*h)
+			if y := OwensT(h, a) + OwensT(a*h, 1/a); tolerance(y, w, 1e-13) == false {
+				t.Fatalf("OwensT(%v, %v) + OwensT(%v, %v): expected %v, got %v", h, a, a*h, 1/a, w, y)
+			}
+		}
+	}
+	for _, a := range []float64{0.1, 0.5, 1, 2, 100} {
+		// T(0,a) = atan(a)/(2π)
+		if y, w := OwensT(0, a), math.Atan(a)/(2*math.Pi); close(y, w) == false {
+			t.Fatalf("OwensT(0, %v): expected %v, got %v", a, w, y)
+		}
+	}
+}
+
+func TestBivariateCDF(t *testing.T) {
+	testCases := []struct {
+		h, k, ρ, y float64
+	}{
+		// extended precision values
+		{-8, -8, -0.7, 6.9727256499022032353436653e-97},
+		{-8, -8, 0, 3.8700350466643926110138171e-31},
+		{-8, -8, 0.3, 1.7506649740250272469632959e-24},
+		{-8, -8, 0.99, 3.5137622005211302458194005e-16},
+		{-8, -1.5, -0.7, 3.8464459243322636513961346e-39},
+		{-8, 2, -0.3, 2.0142419770139875259529102e-16},
+		{-5, -5, 0.7, 8.5370612541781991895315246e-9},
+		{-5, -0.2, -0.99, 2.4064135154047440497548160e-300},
+		{-5, 0.7, -0.99, 7.2587783832318483609648871e-208},
+		{-5, 5, -0.999999, 8.3878998630150382149703791e-10},
+		{-3, -3, 0.3, 2.3851515745155805597226057e-5},
+		{-3, 2, -0.99, 2.5637005143893749408987297e-16},
+		{-1.5, -0.2, 0.3, 4.3707483562464981194173958e-2},
+		{-1.5, 0.7, -0.99, 3.9675682288809308574157519e-11},
+		{0, 0, -0.999999, 2.2507909779910680761474755e-4},
+		{-0.2, -0.2, -0.7, 6.2059787263526092657894753e-2},
+		{-0.2, 0.7, 0.99, 4.2074029056008776252071163e-1},
+		{-0.2, 2, 0, 4.1116839343472130787034812e-1},
+		{-0.2, 2, 0.7, 4.2060208514163786488190265e-1},
+		{0.7, 0.7, 0.7, 6.6030162135831117875356781e-1},
+		{0.7, 2, -0.3, 7.3688967553335346020742155e-1},
+		{1, 2, 0.3, 8.2728251153508304697295433e-1},
+		{2, 2, -0.99, 9.5449973610364158559943473e-1},
+		{2, 2, 0.99, 9.7421137875231053169615357e-1},
+	}
+
+	for _, tc := range testCases {
+		// in the lower tail the rounding errors of a(h,k) are magnified by (a h)²
+		if y := BivariateCDF(tc.h, tc.k, tc.ρ); tolerance(y, tc.y, 5e-13) == false {
+			t.Fatalf("BivariateCDF(%v, %v, %v): expected %v, got %v", tc.h, tc.k, tc.ρ, tc.y, y)
+		}
+	}
+}
+
+func TestBivariateCDFIdentities(t *testing.T) {
+	xs := []float64{-8, -3, -0.5, 0, 0.4, 2.5, 6}
+	for _, h := range xs {
+		for _, k := range xs {
+			// Φ₂(h,k;0) = Φ(h)Φ(k)
+			if y, w := BivariateCDF(h, k, 0), CDF(h)*CDF(k); close(y, w) == false {
+				t.Fatalf("BivariateCDF(%v, %v, 0): expected %v, got %v", h, k, w, y)
+			}
+			for _, ρ := range []float64{-0.95, -0.4, 0.2, 0.8, 0.999} {
+				y := BivariateCDF(h, k, ρ)
+				if w := BivariateCDF(k, h, ρ); y != w {
+					t.Fatalf("BivariateCDF(%v, %v, %v): expected %v, got %v", h, k, ρ, w, y)
+				}
+				// Φ₂(h,k;ρ) + Φ₂(h,-k;-ρ) = Φ(h)
+				if w := CDF(h) - BivariateCDF(h, -k, -ρ); math.Abs(y-w) > 1e-15 {
+					t.Fatalf("BivariateCDF(%v, %v, %v): expected %v, got %v", h, k, ρ, w, y)
+				}
+			}
+		}
+	}
+}
+
+func TestBivariateCDFSpecialCases(t *testing.T) {
+	inf, nan := math.Inf(1), math.NaN()
+	testCases := []struct {
+		h, k, ρ, y float64
+	}{
+		{-inf, 1, 0.5, 0},
+		{1, -inf, 0.5, 0},
+		{inf, inf, 0.5, 1},
+		{inf, 1, 0.5, CDF(1)},
+		{-2, inf, -0.5, CDF(-2)},
+		{1, 2, 1, CDF(1)},
+		{-3, -1, 1, CDF(-3)},
+		{1, 2, -1, CDF(1) - Survival(2)},
+		{-1, 0.5, -1, 0},
+		{0, 0, 0.5, 1.0 / 3},
+		{0, 0, -0.5, 1.0 / 6},
+	}
+
+	for _, tc := range testCases {
+		if y := BivariateCDF(tc.h, tc.k, tc.ρ); close(y, tc.y) == false {
+			t.Fatalf("BivariateCDF(%v, %v, %v): expected %v, got %v", tc.h, tc.k, tc.ρ, tc.y, y)
+		}
+	}
+	for _, tc := range []struct{ h, k, ρ float64 }{{nan, 0, 0}, {0, nan, 0}, {0, 0, nan}, {0, 0, 1.5}, {0, 0, -2}} {
+		if y := BivariateCDF(tc.h, tc.k, tc.ρ); math.IsNaN(y) == false {
+			t.Fatalf("BivariateCDF(%v, %v, %v): expected NaN, got %v", tc.h, tc.k, tc.ρ, y)
+		}
+	}
+	if y := OwensT(nan, 1); math.IsNaN(y) == false {
+		t.Fatalf("OwensT(NaN, 1): expected NaN, got %v", y)
+	}
+	if y := OwensT(1, nan); math.IsNaN(y) == false {
+		t.Fatalf("OwensT(1, NaN): expected NaN, got %v", y)
+	}
+}
+
+// The floating point comparison tests are copied from from math/all_test.go.
+func tolerance(a, b, e float64) bool {
+	// Multiplying by e here can underflow denormal values to zero.
+	// Check a==b so that at least if a and b are small and identical
+	// we say they match.
+	if a == b {
+		return true
+	}
+	d := a - b
+	if d < 0 {
+		d = -d
+	}
+	// note: b is correct (expected) value, a is actual value.
+	// make error tolerance a fraction of b, not a.
+	if b != 0 {
+		e = e * b
+		if e < 0 {
+			e = -e
+		}
+	}
+	return d < e
+}
+
+func close(a, b float64) bool { return tolerance(a, b, 1e-14) }
@@ -0,0 +1,130 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package normal
+
+import (
+	"github.com/dreading/gospecfunc/erf"
+	"math"
+)
+
+// The bivariate normal cumulative distribution function
+//    Φ₂(h,k;ρ) = 1/(2π√(1-ρ²)) ∫ -∞ to h ∫ -∞ to k exp(-(x² - 2ρxy + y²)/(2(1-ρ²))) dy dx
+// follows D. B. Owen, Tables for computing bivariate normal probabilities, Ann. Math. Statist.
+// 27 (1956) 1075-1090, in terms of Owen's T function,
+//    Φ₂(h,k;ρ) = (Φ(h) + Φ(k))/2 - T(h,a(h,k)) - T(k,a(k,h)) - β
+// with a(h,k) = (k - ρh) / (h√(1-ρ²)), and β = 0 if hk > 0 or if hk = 0 and h + k ≥ 0, and
+// β = 1/2 otherwise. The terms are regrouped in the probabilities of wedges
+//    V(h,a) = Φ(-h)/2 - T(h,a) = P(X > h, Y > aX)
+// for h ≥ 0, which are found for a > 1 from
+//    V(h,a) = T(ah,1/a) - Φ(-ah) erf(h/√2)/2
+// Both forms cancel once V is much smaller than Φ(-h), so for ah ≥ 2 the integral
+//    V(h,a) = ∫ h to ∞ φ(x) Φ(-ax) dx
+// is instead taken by Gauss-Laguerre quadrature in the variable u = (1+a²)h(x-h), which is
+// accurate once (1+a²)h² ≥ 9 and keeps the relative accuracy of Φ₂ when h and k are far in
+// the lower tail.
+
+// BivariateCDF computes the standard bivariate normal cumulative distribution function
+// Φ₂(h,k;ρ) = P(X ≤ h, Y ≤ k) with correlation -1 ≤ ρ ≤ 1
+func BivariateCDF(h float64, k float64, ρ float64) float64 {
+	switch {
+	case math.IsNaN(h) || math.IsNaN(k) || math.IsNaN(ρ) || ρ < -1 || ρ > 1:
+		return math.NaN()
+	case math.IsInf(h, -1) || math.IsInf(k, -1):
+		return 0
+	case math.IsInf(h, 1):
+		return CDF(k)
+	case math.IsInf(k, 1):
+		return CDF(h)
+	case ρ == 1:
+		return CDF(math.Min(h, k))
+	case ρ == -1:
+		// P(-k ≤ X ≤ h)
+		if h <= -k {
+			return 0
+		}
+		return CDF(h) - Survival(k)
+	case h == 0 && k == 0:
+		// 1/4 + asin(ρ)/(2π) = acos(-ρ)/(2π) without cancellation as ρ → -1, using
+		// acos(x) = 2 atan(√((1-x)/(1+x))) since math.Acos is found from math.Asin
+		return math.Atan2(math.Sqrt(1+ρ), math.Sqrt(1-ρ)) / math.Pi
+	}
+	s := math.Sqrt((1 - ρ) * (1 + ρ))
+	p := wedge(h, k, ρ, s) + wedge(k, h, ρ, s)
+	return math.Min(math.Max(p, 0), 1)
+}
+
+// wedge computes the term Φ(h)/2 - T(h,a(h,k)) of Φ₂(h,k;ρ), less the part of β assigned to
+// it, given s = √(1-ρ²)
+func wedge(h float64, k float64, ρ float64, s float64) float64 {
+	var a float64
+	if h == 0 {
+		a = math.Copysign(math.Inf(1), k)
+	} else {
+		// k - ρh cancels as ρ → -1 with k near -h, so it is formed with a single rounding
+		a = math.FMA(-ρ, h, k) / (h * s)
+	}
+	switch {
+	case h < 0:
+		return owensV(-h, a)
+	case k >= 0:
+		return 0.5 - owensV(h, -a)
+	}
+	// β = 1/2
+	return -owensV(h, -a)
+}
+
+// owensV computes V(h,a) = Φ(-h)/2 - T(h,a) for h ≥ 0
+func owensV(h float64, a float64) float64 {
+	ah := a * h
+	switch {
+	case math.IsInf(a, 1):
+		return 0
+	case ah >= 2 && h*h+ah*ah >= 9:
+		// (1+a²)h² ≥ 9
+		return owensVTail(h, a, ah)
+	case a <= 1:
+		return Survival(h)/2 - OwensT(h, a)
+	}
+	return OwensT(ah, 1/a) - Survival(ah)*erf.ErfReal(h/math.Sqrt2)/2
+}
+
+// The nodes and weights of the 24 point Gauss-Laguerre rule
+var (
+	laguerreNodes = [...]float64{
+		5.90198521815079799047e-2, 3.11239146198483751515e-1, 7.66096905545936679438e-1,
+		1.42559759080361314609e0, 2.29256205863219042485e0, 3.37077426420899772808e0,
+		4.66508370346717082811e0, 6.18153511873676553279e0, 7.92753924717215241458e0,
+		9.91209801507770649209e0, 1.21461027117297657441e1, 1.46427322895966742777e1,
+		1.74179926465089778276e1, 2.04914600826164239322e1, 2.38873298481697347029e1,
+		2.76359371743327173476e1, 3.17760413523747224929e1, 3.63584058016516209477e1,
+		4.14517204848707692122e1, 4.71531064451563253215e1, 5.36085745446950667770e1,
+		6.10585314472187619117e1, 6.99622400351050259815e1, 8.14982792339488923972e1,
+	}
+	laguerreWeights = [...]float64{
+		1.42811973334781849854e-1, 2.58774107517423912483e-1, 2.58806707272869807035e-1,
+		1.83322688977778036623e-1, 9.81662726299188936885e-2, 4.07324781514086450840e-2,
+		1.32260194051201564885e-2, 3.36934905847830357822e-3, 6.72162564093547933858e-4,
+		1.04461214659275179197e-4, 1.25447219779933324062e-5, 1.15131581273727999368e-6,
+		7.96081295913363043240e-8, 4.07285898754999957676e-9, 1.50700822629258499822e-10,
+		3.91773651505845128930e-12, 6.89418105295808512572e-14, 7.81980038245944832029e-16,
+		5.35018881301003749446e-18, 2.01051746455550338399e-20, 3.60576586455295930642e-23,
+		2.45181884587840271245e-26, 4.08830159368065812447e-30, 5.57534578832835661316e-35,
+	}
+)
+
+// owensVTail integrates
+//    V(h,a) = φ(h)φ(ah)/(ch) ∫ 0 to ∞ exp(-u) exp(-u²/(2ch²)) R(a(h + u/(ch))) du
+// with c = 1 + a² and R the Mills ratio by the 24 point Gauss-Laguerre rule, given ah = a h
+func owensVTail(h float64, a float64, ah float64) float64 {
+	// ch = (1+a²)h may overflow for large a, and then V(h,a) underflows
+	ch := h + a*ah
+	v := 0.0
+	for i, u := range laguerreNodes {
+		// t = x - h, so that u²/(2ch²) = ut/(2h)
+		t := u / ch
+		v += laguerreWeights[i] * math.Exp(-u*t/(2*h)) * MillsRatio(ah+a*t)
+	}
+	return PDF(h) * PDF(ah) / ch * v
+}
@@ -0,0 +1,77 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package normal
+
+import (
+	"github.com/dreading/gospecfunc/erf"
+	"math"
+)
+
+// The standard normal distribution functions are found from the error functions by
+//    Φ(x) = erfc(-x/√2) / 2
+//    R(x) = (1 - Φ(x)) / φ(x) = √(π/2) erfcx(x/√2)
+// In the lower tail Φ(x) = φ(x) R(-x) and log Φ(x) = log(erfcx(-x/√2)/2) - x²/2, which need
+// no erfc of a large argument, so that log Φ(x) is accurate far below the underflow of Φ(x).
+// For x < 0 the Mills ratio is R(x) = 1/φ(x) - R(-x). The rounding error of x/√2 is then not
+// magnified, as erfcx(t) is well conditioned for t > 0, and exp(∓x²/2) is computed with x²
+// split exactly into two parts.
+
+// PDF computes the standard normal probability density function φ(x) = exp(-x²/2) / √(2π)
+func PDF(x float64) float64 {
+	return expx2h(x, -1) / math.Sqrt(2*math.Pi)
+}
+
+// CDF computes the standard normal cumulative distribution function Φ(x)
+func CDF(x float64) float64 {
+	if x < -1 {
+		return expx2h(x, -1) * erf.ErfcxReal(-x/math.Sqrt2) / 2
+	}
+	return erf.ErfcReal(-x/math.Sqrt2) / 2
+}
+
+// Survival computes the standard normal survival function 1 - Φ(x) = Φ(-x)
+func Survival(x float64) float64 {
+	return CDF(-x)
+}
+
+// LogCDF computes the logarithm of the standard normal cumulative distribution function log Φ(x)
+func LogCDF(x float64) float64 {
+	if x < -1 {
+		return math.Log(erf.ErfcxReal(-x/math.Sqrt2)/2) - x*x/2
+	}
+	return math.Log1p(-Survival(x))
+}
+
+// MillsRatio computes the Mills ratio R(x) = (1 - Φ(x)) / φ(x)
+func MillsRatio(x float64) float64 {
+	if x < 0 {
+		return math.Sqrt(2*math.Pi)*expx2h(x, 1) - MillsRatio(-x)
+	}
+	return math.Sqrt(math.Pi/2) * erf.ErfcxReal(x/math.Sqrt2)
+}
+
+// Hazard computes the standard normal hazard function h(x) = φ(x) / (1 - Φ(x)) = 1 / R(x)
+func Hazard(x float64) float64 {
+	return 1 / MillsRatio(x)
+}
+
+// Quantile computes the standard normal quantile function Φ⁻¹(p) = -√2 erfc⁻¹(2p) for 0 ≤ p ≤ 1
+func Quantile(p float64) float64 {
+	return -math.Sqrt2 * erf.ErfcInv(2*p)
+}
+
+// expx2h computes exp(sign x²/2) for sign = ±1, with x² = h + l split exactly by Dekker's
+// algorithm so that exp(sign x²/2) = exp(sign h/2) (1 + sign l/2) to within rounding
+func expx2h(x float64, sign float64) float64 {
+	h := x * x
+	if math.IsInf(h, 1) {
+		return math.Exp(sign * h)
+	}
+	c := 134217729 * x
+	xh := c - (c - x)
+	xl := x - xh
+	l := ((xh*xh - h) + 2*xh*xl) + xl*xl
+	return math.Exp(sign*h/2) * (1 + sign*l/2)
+}
@@ -0,0 +1,248 @@
+// Copyright 2019 Infin IT Pty Ltd. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package normal
+
+import (
+	"github.com/dreading/gospecfunc/erf"
+	"math"
+)
+
+// Owen's T function
+//    T(h,a) = 1/(2π) ∫ 0 to a exp(-h²(1+x²)/2) / (1+x²) dx
+// follows M. Patefield and D. Tandy, Fast and accurate calculation of Owen's T function,
+// J. Stat. Softw. 5 (2000) 1-25. For 0 ≤ a ≤ 1 one of six methods, and its order, is selected
+// from a table over the (h, a) plane: T1, the series of Owen in powers of a², T2, a series in
+// powers of 1/h² whose terms are found by recurrence, T3, the same series with coefficients
+// economised by Chebyshev polynomials, T4, a series in powers of a² with a different
+// recurrence, T5, Gauss-Legendre quadrature of the integral, and T6 for a near 1. For a > 1,
+//    T(h,a) = (Φ(-h) + Φ(-ah))/2 - Φ(-h)Φ(-ah) - T(ah,1/a)
+// for h ≥ 0, and T(h,a) = T(-h,a) = -T(h,-a). The factors exp(-h²/2) are computed with h²
+// split exactly, which keeps the relative accuracy of T(h,a) for large h.
+
+// OwensT computes Owen's T function T(h,a)
+func OwensT(h float64, a float64) float64 {
+	switch {
+	case math.IsNaN(h) || math.IsNaN(a):
+		return math.NaN()
+	case a < 0:
+		return -OwensT(h, -a)
+	case math.IsInf(a, 1):
+		// T(h,∞) = Φ(-|h|)/2
+		return CDF(-math.Abs(h)) / 2
+	}
+	h = math.Abs(h)
+	if a <= 1 {
+		return owensT(h, a, a*h)
+	}
+	ah := a * h
+	if h <= 0.67 {
+		// Φ(-h) = 1/2 - erf(h/√2)/2
+		eh, eah := erf.ErfReal(h/math.Sqrt2)/2, erf.ErfReal(ah/math.Sqrt2)/2
+		return 0.25 - eh*eah - owensT(ah, 1/a, h)
+	}
+	qh, qah := CDF(-h), CDF(-ah)
+	return (qh+qah)/2 - qh*qah - owensT(ah, 1/a, h)
+}
+
+// The boundaries of the regions of the (h, a) plane
+var (
+	owensH = [...]float64{0.02, 0.06, 0.09, 0.125, 0.26, 0.4, 0.6, 1.6, 1.7, 2.33, 2.4, 3.36, 3.4, 4.8}
+	owensA = [...]float64{0.025, 0.09, 0.15, 0.36, 0.5, 0.9, 0.99999}
+)
+
+// owensSelect is the method of each region of the (h, a) plane
+var owensSelect = [8][15]int{
+	{0, 0, 1, 12, 12, 12, 12, 12, 12, 12, 12, 15, 15, 15, 8},
+	{0, 1, 1, 2, 2, 4, 4, 13, 13, 14, 14, 15, 15, 15, 8},
+	{1, 1, 2, 2, 2, 4, 4, 14, 14, 14, 14, 15, 15, 15, 9},
+	{1, 1, 2, 4, 4, 4, 4, 6, 6, 15, 15, 15, 15, 15, 9},
+	{1, 2, 2, 4, 4, 5, 5, 7, 7, 16, 16, 16, 11, 11, 10},
+	{1, 2, 4, 4, 4, 5, 5, 7, 7, 16, 16, 16, 11, 11, 11},
+	{1, 2, 3, 3, 5, 5, 7, 7, 16, 16, 16, 16, 16, 11, 11},
+	{1, 2, 3, 3, 5, 5, 17, 17, 17, 17, 16, 16, 16, 11, 11},
+}
+
+// The algorithm T1 to T6 and the order of each method
+var (
+	owensMethod = [...]int{1, 1, 1, 1, 1, 1, 1, 1, 2, 2, 2, 3, 4, 4, 4, 4, 5, 6}
+	owensOrder  = [...]int{2, 3, 4, 5, 7, 10, 12, 18, 10, 20, 30, 20, 4, 7, 8, 20, 13, 0}
+)
+
+// owensT computes T(h,a) for h ≥ 0 and 0 ≤ a ≤ 1, given ah = a h
+func owensT(h float64, a float64, ah float64) float64 {
+	switch {
+	case h == 0:
+		return math.Atan(a) / (2 * math.Pi)
+	case a == 0:
+		return 0
+	case a == 1:
+		// T(h,1) = Φ(h)Φ(-h)/2
+		return CDF(-h) * CDF(h) / 2
+	}
+	i, j := len(owensH), len(owensA)
+	for k, v := range owensH {
+		if h <= v {
+			i = k
+			break
+		}
+	}
+	for k, v := range owensA {
+		if a <= v {
+			j = k
+			break
+		}
+	}
+	code := owensSelect[j][i]
+	m := owensOrder[code]
+	switch owensMethod[code] {
+	case 1:
+		return owensT1(h, a, m)
+	case 2:
+		return owensT2(h, a, m, ah)
+	case 3:
+		return owensT3(h, a, ah)
+	case 4:
+		return owensT4(h, a, m)
+	case 5:
+		return owensT5(h, a)
+	}
+	return owensT6(h, a)
+}
+
+// owensT1 sums m terms of the series
+//    T(h,a) = atan(a)/(2π) - 1/(2π) Σ (-1)^j a^(2j+1)/(2j+1) (1 - exp(-h²/2) Σ i=0 to j (h²/2)^i/i!)
+func owensT1(h float64, a float64, m int) float64 {
+	hs := -h * h / 2
+	dhs := expx2h(h, -1)
+	as := a * a
+	aj := a / (2 * math.Pi)
+	dj := math.Expm1(hs)
+	gj := hs * dhs
+	t := math.Atan(a) / (2 * math.Pi)
+	jj := 1.0
+	for j := 1; ; j++ {
+		t += dj * aj / jj
+		if j >= m {
+			return t
+		}
+		jj += 2
+		aj *= as
+		dj = gj - dj
+		gj *= hs / float64(j+1)
+	}
+}
+
+// owensT2 sums 2m+1 terms of the series of T(h,a) in powers of 1/h²
+func owensT2(h float64, a float64, m int, ah float64) float64 {
+	hs := h * h
+	as := -a * a
+	y := 1 / hs
+	vi := a * PDF(ah)
+	z := erf.ErfReal(ah/math.Sqrt2) / (2 * h)
+	t := 0.0
+	for i := 1; ; i += 2 {
+		t += z
+		if i >= 2*m+1 {
+			return t * PDF(h)
+		}
+		z = y * (vi - float64(i)*z)
+		vi *= as
+	}
+}
+
+// owensC2 are the coefficients of T3, the series of T2 economised by Chebyshev polynomials
+var owensC2 = [...]float64{
+	0.99999999999999987510,
+	-0.99999999999988796462, 0.99999999998290743652,
+	-0.99999999896282500134, 0.99999996660459362918,
+	-0.99999933986272476760, 0.99999125611136965852,
+	-0.99991777624463387686, 0.99942835555870132569,
+	-0.99697311720723000295, 0.98751448037275303682,
+	-0.95915857980572882813, 0.89246305511006708555,
+	-0.76893425990463999675, 0.58893528468484693250,
+	-0.38380345160440256652, 0.20317601701045299653,
+	-0.82813631607004984866e-01, 0.24167984735759576523e-01,
+	-0.44676566663971825242e-02, 0.39141169402373836468e-03,
+}
+
+// owensT3 sums the series of T2 with the coefficients owensC2
+func owensT3(h float64, a float64, ah float64) float64 {
+	as := a * a
+	hs := h * h
+	y := 1 / hs
+	vi := a * PDF(ah)
+	z := erf.ErfReal(ah/math.Sqrt2) / (2 * h)
+	t := 0.0
+	for i, c := range owensC2 {
+		t += z * c
+		z = y * (float64(2*i+1)*z - vi)
+		vi *= as
+	}
+	return t * PDF(h)
+}
+
+// owensT4 sums m+1 terms of the series
+//    T(h,a) = a/(2π) exp(-h²(1+a²)/2) Σ (-a²)^i y(i)
+// with y(0) = 1 and y(i) = (1 - h² y(i-1)) / (2i+1)
+func owensT4(h float64, a float64, m int) float64 {
+	hs := h * h
+	as := -a * a
+	ai := a * expx2h(h, -1) * expx2h(a*h, -1) / (2 * math.Pi)
+	yi := 1.0
+	t := 0.0
+	for i := 1; ; i += 2 {
+		t += ai * yi
+		if i >= 2*m+1 {
+			return t
+		}
+		yi = (1 - hs*yi) / float64(i+2)
+		ai *= as
+	}
+}
+
+// The squares of the positive nodes of the 26 point Gauss-Legendre rule on [-1, 1], and
+// the weights divided by 2π
+var (
+	owensNodes = [...]float64{
+		3.50820396764517154889e-3, 3.12790423380307537401e-2, 8.52668262832194510904e-2,
+		1.62450717308122770107e-1, 2.58511960491254348284e-1, 3.68075538406975335361e-1,
+		4.85010929056046974749e-1, 6.02775141526185768211e-1, 7.14778842177532265157e-1,
+		8.14755109887600986047e-1, 8.97110297559489658673e-1, 9.57238080859442618430e-1,
+		9.91788329746297035857e-1,
+	}
+	owensWeights = [...]float64{
+		1.88314381153235028868e-2, 1.85670862439776494784e-2, 1.80420934612233855843e-2,
+		1.72638296063987533639e-2, 1.62432199759898567303e-2, 1.49945920341167048294e-2,
+		1.35354744696620883916e-2, 1.18863516058201652334e-2, 1.00703772427774318974e-2,
+		8.11305457422995866294e-3, 6.04190095284702387726e-3, 3.88622170107420578830e-3,
+		1.67930310845460904480e-3,
+	}
+)
+
+// owensT5 integrates T(h,a) by the 26 point Gauss-Legendre rule
+func owensT5(h float64, a float64) float64 {
+	as := a * a
+	hs := -h * h / 2
+	t := 0.0
+	for i, x := range owensNodes {
+		r := 1 + as*x
+		t += owensWeights[i] * math.Exp(hs*r) / r
+	}
+	return a * t
+}
+
+// owensT6 computes T(h,a) for a near 1 from
+//    T(h,a) ≈ Φ(h)Φ(-h)/2 - r/(2π) exp(-(1-a)h²/(2r))
+// with r = atan((1-a)/(1+a))
+func owensT6(h float64, a float64) float64 {
+	q := CDF(-h)
+	y := 1 - a
+	r := math.Atan2(y, 1+a)
+	t := q * (1 - q) / 2
+	if r != 0 {
+		t -= r * math.Exp(-y*h*h/(2*r)) / (2 * math.Pi)
+	}
+	return t
+}